@@ -0,0 +1,446 @@
+package mlpack
+
+import (
+  "bufio"
+  "fmt"
+  "io"
+  "math"
+  "strconv"
+  "strings"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// ARFFHeader holds the declarations of an ARFF file.
+type ARFFHeader struct {
+  // Relation is the name given with @relation.
+  Relation string
+  // Attributes describes each column of the data, in order.
+  Attributes []ARFFAttribute
+}
+
+// ARFFAttribute describes a column of an ARFF file.
+type ARFFAttribute struct {
+  // Name is the name of the attribute.
+  Name string
+  // Type is "numeric", "real" or "integer" for numeric attributes, and
+  // "nominal" or "string" for categorical ones.
+  Type string
+  // Values maps the categories of a categorical attribute to strings: category
+  // i of the column stands for Values[i].  For nominal attributes these are the
+  // declared values in order; for string attributes, the values in the order
+  // they first appear in the data.  Values is nil for numeric attributes.
+  Values []string
+}
+
+// Reports whether the attribute is categorical.
+func (a *ARFFAttribute) categorical() bool {
+  return a.Type == "nominal" || a.Type == "string"
+}
+
+// ARFFError reports a line of an ARFF file that LoadARFF() cannot read.
+type ARFFError struct {
+  // Line is the number of the line, starting at 1.
+  Line int
+  // Message describes the problem.
+  Message string
+}
+
+// Error implements the error interface.
+func (e *ARFFError) Error() string {
+  return fmt.Sprintf("mlpack: ARFF line %d: %s", e.Line, e.Message)
+}
+
+// LoadARFF reads an ARFF file from r.  Each instance becomes a row of the
+// returned matrix, with the Categoricals mask set for nominal and string
+// attributes, so that it can be passed to bindings such as DecisionTree() or
+// PreprocessOneHotEncoding().  Categorical cells hold the index of their
+// value in the Values of the attribute, which the returned header gives.
+// Missing values ("?") are read as NaN.  Both dense and sparse instances are
+// supported; the values left out of a sparse instance are 0, which for
+// categorical attributes is their first value.  Date and relational
+// attributes and instance weights are not supported.
+func LoadARFF(r io.Reader) (*matrixWithInfo, *ARFFHeader, error) {
+  scanner := bufio.NewScanner(r)
+  scanner.Buffer(nil, 1<<30)
+  header := &ARFFHeader{}
+  var indexes []map[string]int
+  var data []float64
+  rows := 0
+  inData := false
+  for line := 1; scanner.Scan(); line++ {
+    text := strings.TrimSpace(scanner.Text())
+    if text == "" || text[0] == '%' {
+      continue
+    }
+    fail := func(format string, args ...interface{}) error {
+      return &ARFFError{Line: line, Message: fmt.Sprintf(format, args...)}
+    }
+
+    if !inData {
+      keyword, rest := splitARFFWord(text)
+      switch strings.ToLower(keyword) {
+      case "@relation":
+        name, _, err := readARFFName(rest)
+        if err != nil {
+          return nil, nil, fail("%v", err)
+        }
+        header.Relation = name
+      case "@attribute":
+        attr, err := parseARFFAttribute(rest)
+        if err != nil {
+          return nil, nil, fail("%v", err)
+        }
+        index := make(map[string]int)
+        for i, v := range attr.Values {
+          index[v] = i
+        }
+        header.Attributes = append(header.Attributes, attr)
+        indexes = append(indexes, index)
+      case "@data":
+        if len(header.Attributes) == 0 {
+          return nil, nil, fail("@data before any @attribute")
+        }
+        inData = true
+      default:
+        return nil, nil, fail("unexpected %q in header", keyword)
+      }
+      continue
+    }
+
+    var values []string
+    var columns []int
+    var err error
+    if text[0] == '{' {
+      values, columns, err = splitARFFSparse(text, len(header.Attributes))
+    } else {
+      values, err = splitARFFValues(text)
+      if err == nil && len(values) != len(header.Attributes) {
+        err = fmt.Errorf("has %d values, but %d attributes are declared",
+            len(values), len(header.Attributes))
+      }
+    }
+    if err != nil {
+      return nil, nil, fail("%v", err)
+    }
+
+    row := make([]float64, len(header.Attributes))
+    if columns == nil {
+      columns = make([]int, len(values))
+      for i := range columns {
+        columns[i] = i
+      }
+    }
+    for i, j := range columns {
+      attr := &header.Attributes[j]
+      value := values[i]
+      switch {
+      case value == "?":
+        row[j] = math.NaN()
+      case attr.Type == "string":
+        index, ok := indexes[j][value]
+        if !ok {
+          index = len(attr.Values)
+          attr.Values = append(attr.Values, value)
+          indexes[j][value] = index
+        }
+        row[j] = float64(index)
+      case attr.Type == "nominal":
+        index, ok := indexes[j][value]
+        if !ok {
+          return nil, nil, fail("%q is not a value of attribute %q", value,
+              attr.Name)
+        }
+        row[j] = float64(index)
+      default:
+        n, err := strconv.ParseFloat(value, 64)
+        if err != nil {
+          return nil, nil, fail("%q is not a number", value)
+        }
+        row[j] = n
+      }
+    }
+    data = append(data, row...)
+    rows++
+  }
+  if err := scanner.Err(); err != nil {
+    return nil, nil, err
+  }
+  if rows == 0 {
+    return nil, nil, fmt.Errorf("mlpack: ARFF has no data")
+  }
+
+  categoricals := make([]bool, len(header.Attributes))
+  for j := range header.Attributes {
+    categoricals[j] = header.Attributes[j].categorical()
+  }
+  return &matrixWithInfo{
+    Categoricals: categoricals,
+    Data: mat.NewDense(rows, len(header.Attributes), data),
+  }, header, nil
+}
+
+// Parses the part of an @attribute line after the keyword.
+func parseARFFAttribute(text string) (ARFFAttribute, error) {
+  name, rest, err := readARFFName(text)
+  if err != nil {
+    return ARFFAttribute{}, err
+  }
+  rest = strings.TrimSpace(rest)
+  if strings.HasPrefix(rest, "{") {
+    if !strings.HasSuffix(rest, "}") {
+      return ARFFAttribute{}, fmt.Errorf("unterminated values of " +
+          "attribute %q", name)
+    }
+    values, err := splitARFFValues(rest[1:len(rest)-1])
+    if err != nil {
+      return ARFFAttribute{}, err
+    }
+    return ARFFAttribute{Name: name, Type: "nominal", Values: values}, nil
+  }
+
+  typ, _ := splitARFFWord(rest)
+  switch t := strings.ToLower(typ); t {
+  case "numeric", "real", "integer", "string":
+    return ARFFAttribute{Name: name, Type: t}, nil
+  case "":
+    return ARFFAttribute{}, fmt.Errorf("attribute %q has no type", name)
+  }
+  return ARFFAttribute{}, fmt.Errorf("type %q of attribute %q is not " +
+      "supported", typ, name)
+}
+
+// Splits the first whitespace-separated word from text.
+func splitARFFWord(text string) (string, string) {
+  text = strings.TrimSpace(text)
+  if i := strings.IndexAny(text, " \t"); i >= 0 {
+    return text[:i], text[i+1:]
+  }
+  return text, ""
+}
+
+// Reads a name, which may be quoted, from the start of text, and returns the
+// rest of the text.
+func readARFFName(text string) (string, string, error) {
+  text = strings.TrimSpace(text)
+  if text == "" {
+    return "", "", fmt.Errorf("missing name")
+  }
+  if text[0] != '\'' && text[0] != '"' {
+    name, rest := splitARFFWord(text)
+    return name, rest, nil
+  }
+  name, n, err := readARFFQuoted(text)
+  return name, text[n:], err
+}
+
+// Reads a quoted string from the start of text, and returns it unescaped
+// along with the number of bytes it takes up.
+func readARFFQuoted(text string) (string, int, error) {
+  quote := text[0]
+  var out strings.Builder
+  for i := 1; i < len(text); i++ {
+    switch c := text[i]; {
+    case c == '\\' && i+1 < len(text):
+      i++
+      out.WriteByte(text[i])
+    case c == quote:
+      return out.String(), i + 1, nil
+    default:
+      out.WriteByte(c)
+    }
+  }
+  return "", 0, fmt.Errorf("unterminated quote in %q", text)
+}
+
+// Reads a value, which may be quoted, from the start of text.  Returns the
+// rest of the text, which is empty or starts with the comma after the value.
+func readARFFValue(text string) (string, string, error) {
+  text = strings.TrimLeft(text, " \t")
+  if text != "" && (text[0] == '\'' || text[0] == '"') {
+    value, n, err := readARFFQuoted(text)
+    if err != nil {
+      return "", "", err
+    }
+    text = strings.TrimLeft(text[n:], " \t")
+    if text != "" && text[0] != ',' {
+      return "", "", fmt.Errorf("unexpected %q after quoted value", text)
+    }
+    return value, text, nil
+  }
+  i := strings.IndexByte(text, ',')
+  if i < 0 {
+    i = len(text)
+  }
+  return strings.TrimSpace(text[:i]), text[i:], nil
+}
+
+// Splits comma-separated values, which may be quoted.
+func splitARFFValues(text string) ([]string, error) {
+  var values []string
+  for {
+    value, rest, err := readARFFValue(text)
+    if err != nil {
+      return nil, err
+    }
+    values = append(values, value)
+    if rest == "" {
+      return values, nil
+    }
+    text = rest[1:]
+  }
+}
+
+// Splits a sparse instance, such as "{0 1.5, 3 'yes, really'}", into its
+// values and their columns.
+func splitARFFSparse(text string, cols int) ([]string, []int, error) {
+  if !strings.HasSuffix(text, "}") {
+    return nil, nil, fmt.Errorf("unterminated sparse instance")
+  }
+  text = strings.TrimSpace(text[1:len(text)-1])
+  var values []string
+  columns := []int{}
+  for text != "" {
+    index, rest := splitARFFWord(text)
+    j, err := strconv.Atoi(index)
+    if err != nil || j < 0 || j >= cols {
+      return nil, nil, fmt.Errorf("invalid attribute index %q", index)
+    }
+    value, rest, err := readARFFValue(rest)
+    if err != nil {
+      return nil, nil, err
+    }
+    values = append(values, value)
+    columns = append(columns, j)
+    if rest == "" {
+      break
+    }
+    if text = strings.TrimSpace(rest[1:]); text == "" {
+      return nil, nil, fmt.Errorf("missing value after ','")
+    }
+  }
+  return values, columns, nil
+}
+
+// SaveARFF writes data to w as an ARFF file that LoadARFF() and mlpack can
+// read.  header gives the relation and the attributes; the Values of each
+// categorical attribute give the strings that its categories are written
+// as.  String attributes with Values are declared as nominal ones, so that
+// loading the file gives back the same categories whatever order the values
+// appear in.  If header is nil, the attributes are named after their column, and
+// categorical columns are written as the indexes of their categories.  NaN is
+// written as a missing value.
+func SaveARFF(w io.Writer, data *matrixWithInfo, header *ARFFHeader) error {
+  if data == nil || data.Data == nil {
+    return fmt.Errorf("mlpack: no data to save")
+  }
+  r, c := data.Data.Dims()
+  if data.Categoricals != nil && len(data.Categoricals) != c {
+    return fmt.Errorf("mlpack: categoricals must have one entry for each " +
+        "column of the data")
+  }
+  if header == nil {
+    header = defaultARFFHeader(data)
+  }
+  if len(header.Attributes) != c {
+    return fmt.Errorf("mlpack: header has %d attributes, but the data has " +
+        "%d columns", len(header.Attributes), c)
+  }
+
+  out := bufio.NewWriter(w)
+  relation := header.Relation
+  if relation == "" {
+    relation = "mlpack"
+  }
+  fmt.Fprintf(out, "@relation %s\n\n", quoteARFF(relation))
+  for _, attr := range header.Attributes {
+    fmt.Fprintf(out, "@attribute %s ", quoteARFF(attr.Name))
+    switch {
+    case attr.Type == "nominal" || (attr.Type == "string" &&
+        len(attr.Values) > 0):
+      values := make([]string, len(attr.Values))
+      for i, v := range attr.Values {
+        values[i] = quoteARFF(v)
+      }
+      fmt.Fprintf(out, "{%s}\n", strings.Join(values, ","))
+    case attr.Type == "":
+      out.WriteString("numeric\n")
+    default:
+      out.WriteString(attr.Type + "\n")
+    }
+  }
+  out.WriteString("\n@data\n")
+
+  record := make([]string, c)
+  for i := 0; i < r; i++ {
+    for j := 0; j < c; j++ {
+      v := data.Data.At(i, j)
+      attr := &header.Attributes[j]
+      switch {
+      case math.IsNaN(v):
+        record[j] = "?"
+      case attr.categorical():
+        k := int(v)
+        if float64(k) != v || k < 0 || k >= len(attr.Values) {
+          return fmt.Errorf("mlpack: row %d, column %d: %v is not a " +
+              "category of attribute %q", i, j, v, attr.Name)
+        }
+        record[j] = quoteARFF(attr.Values[k])
+      default:
+        record[j] = strconv.FormatFloat(v, 'g', -1, 64)
+      }
+    }
+    out.WriteString(strings.Join(record, ",") + "\n")
+  }
+  return out.Flush()
+}
+
+// Returns the header that SaveARFF() uses when none is given.
+func defaultARFFHeader(data *matrixWithInfo) *ARFFHeader {
+  r, c := data.Data.Dims()
+  header := &ARFFHeader{Attributes: make([]ARFFAttribute, c)}
+  for j := range header.Attributes {
+    attr := &header.Attributes[j]
+    attr.Name = "dimension_" + strconv.Itoa(j)
+    attr.Type = "numeric"
+    if data.Categoricals == nil || !data.Categoricals[j] {
+      continue
+    }
+    max := -1
+    for i := 0; i < r; i++ {
+      if v := data.Data.At(i, j); v > float64(max) {
+        max = int(v)
+      }
+    }
+    attr.Type = "nominal"
+    for k := 0; k <= max; k++ {
+      attr.Values = append(attr.Values, strconv.Itoa(k))
+    }
+  }
+  return header
+}
+
+// Quotes a name or value if ARFF requires it.
+func quoteARFF(s string) string {
+  if s != "" && s != "?" && !strings.ContainsAny(s, " \t,'\"%{}\\") {
+    return s
+  }
+  return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// DatasetInfo returns the DatasetInfo of the attributes, with the categories
+// of each categorical attribute mapped to the same indexes as by LoadARFF().
+func (h *ARFFHeader) DatasetInfo() *DatasetInfo {
+  info := NewDatasetInfo(len(h.Attributes))
+  for dim := range h.Attributes {
+    attr := &h.Attributes[dim]
+    if !attr.categorical() {
+      continue
+    }
+    info.SetType(dim, DatatypeCategorical)
+    for _, v := range attr.Values {
+      info.MapString(v, dim)
+    }
+  }
+  return info
+}
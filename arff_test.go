@@ -0,0 +1,163 @@
+package mlpack
+
+import (
+  "bytes"
+  "math"
+  "reflect"
+  "strings"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+const testARFF = `% A comment.
+@relation 'weather data'
+
+@attribute outlook {sunny, overcast, 'light rain'}
+@attribute temperature numeric
+@attribute note string
+
+@data
+sunny, 85, 'hot, dry'
+'light rain', ?, mild
+{0 overcast, 2 'hot, dry'}
+{1 64.5}
+`
+
+func TestLoadARFF(t *testing.T) {
+  data, header, err := LoadARFF(strings.NewReader(testARFF))
+  if err != nil {
+    t.Fatal(err)
+  }
+  if header.Relation != "weather data" {
+    t.Errorf("relation = %q", header.Relation)
+  }
+  wantAttrs := []ARFFAttribute{
+    {"outlook", "nominal", []string{"sunny", "overcast", "light rain"}},
+    {"temperature", "numeric", nil},
+    {"note", "string", []string{"hot, dry", "mild"}},
+  }
+  if !reflect.DeepEqual(header.Attributes, wantAttrs) {
+    t.Errorf("attributes = %+v, want %+v", header.Attributes, wantAttrs)
+  }
+  if want := []bool{true, false, true}; !reflect.DeepEqual(data.Categoricals,
+      want) {
+    t.Errorf("categoricals = %v, want %v", data.Categoricals, want)
+  }
+  checkRows(t, data.Data.(*mat.Dense), [][]float64{
+    {0, 85, 0},
+    {2, math.NaN(), 1},
+    {1, 0, 0},
+    {0, 64.5, 0},
+  })
+}
+
+func TestARFFRoundTrip(t *testing.T) {
+  data, header, err := LoadARFF(strings.NewReader(testARFF))
+  if err != nil {
+    t.Fatal(err)
+  }
+  var buf bytes.Buffer
+  if err := SaveARFF(&buf, data, header); err != nil {
+    t.Fatal(err)
+  }
+  loaded, loadedHeader, err := LoadARFF(&buf)
+  if err != nil {
+    t.Fatalf("%v in\n%s", err, buf.String())
+  }
+  if loadedHeader.Relation != header.Relation {
+    t.Errorf("relation = %q, want %q", loadedHeader.Relation,
+        header.Relation)
+  }
+  for j, attr := range loadedHeader.Attributes {
+    want := header.Attributes[j]
+    if attr.Name != want.Name || !reflect.DeepEqual(attr.Values, want.Values) {
+      t.Errorf("attribute %d = %+v, want %+v", j, attr, want)
+    }
+  }
+  if !reflect.DeepEqual(loaded.Categoricals, data.Categoricals) {
+    t.Errorf("categoricals = %v, want %v", loaded.Categoricals,
+        data.Categoricals)
+  }
+  checkRows(t, loaded.Data.(*mat.Dense), denseRows(data.Data))
+}
+
+// The categories of a string attribute do not depend on the order in which
+// the saved rows use them.
+func TestARFFStringOrder(t *testing.T) {
+  header := &ARFFHeader{Attributes: []ARFFAttribute{
+    {Name: "s", Type: "string", Values: []string{"a", "b", "c"}},
+  }}
+  data := DataAndInfo()
+  data.Data = mat.NewDense(3, 1, []float64{2, 0, 1})
+  data.Categoricals = []bool{true}
+
+  var buf bytes.Buffer
+  if err := SaveARFF(&buf, data, header); err != nil {
+    t.Fatal(err)
+  }
+  loaded, loadedHeader, err := LoadARFF(&buf)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if got := loadedHeader.Attributes[0].Values; !reflect.DeepEqual(got,
+      header.Attributes[0].Values) {
+    t.Errorf("values = %q, want %q", got, header.Attributes[0].Values)
+  }
+  checkRows(t, loaded.Data.(*mat.Dense), [][]float64{{2}, {0}, {1}})
+}
+
+func TestARFFSparseQuoted(t *testing.T) {
+  values, columns, err := splitARFFSparse("{0 'a,b', 1 2,3 \"c\"}", 4)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if want := []string{"a,b", "2", "c"}; !reflect.DeepEqual(values, want) {
+    t.Errorf("values = %q, want %q", values, want)
+  }
+  if want := []int{0, 1, 3}; !reflect.DeepEqual(columns, want) {
+    t.Errorf("columns = %v, want %v", columns, want)
+  }
+
+  for _, bad := range []string{"{4 1}", "{0 1,}", "{0 'a}", "{0 'a' b}",
+      "{x 1}", "{0 1"} {
+    if _, _, err := splitARFFSparse(bad, 4); err == nil {
+      t.Errorf("splitARFFSparse(%q) succeeded", bad)
+    }
+  }
+}
+
+func TestLoadARFFErrors(t *testing.T) {
+  tests := []struct {
+    name string
+    in string
+    line int
+  }{
+    {"unknown value", "@attribute a {x, y}\n@data\nx\nz\n", 4},
+    {"wrong count", "@attribute a numeric\n\n@data\n1,2\n", 4},
+    {"not a number", "@attribute a numeric\n@data\nabc\n", 3},
+    {"unsupported type", "@attribute a date\n@data\n", 1},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      _, _, err := LoadARFF(strings.NewReader(test.in))
+      arffErr, ok := err.(*ARFFError)
+      if !ok {
+        t.Fatalf("got error %v, want an *ARFFError", err)
+      }
+      if arffErr.Line != test.line {
+        t.Errorf("error on line %d, want %d", arffErr.Line, test.line)
+      }
+    })
+  }
+}
+
+// Returns the rows of m.
+func denseRows(m mat.Matrix) [][]float64 {
+  r, _ := m.Dims()
+  rows := make([][]float64, r)
+  for i := range rows {
+    rows[i] = mat.Row(nil, i, m)
+  }
+  return rows
+}
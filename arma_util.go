@@ -1,3 +1,5 @@
+// +build !nomlpack
+
 package mlpack
 
 /*
@@ -21,64 +23,45 @@ type mlpackArma struct {
   mem unsafe.Pointer
 }
 
-// A Tuple containing `float64` data (data) along with a boolean array
-// (Categoricals) indicating which dimensions are categorical (represented by
-// `true`) and which are numeric (represented by `false`).  The number of
-// elements in the boolean array should be the same as the dimensionality of
-// the data matrix.  It is expected that each row of the matrix corresponds to a
-// single data point when calling mlpack bindings.
-type matrixWithInfo struct {
-  Categoricals []bool
-  Data *mat.Dense
-}
-
-// A function used for initializing matrixWithInfo Tuple.
-func DataAndInfo() *matrixWithInfo {
-  return &matrixWithInfo {
-    Categoricals: nil,
-    Data: nil,
-  }
-}
-
 // Allocates a C memory Pointer via cgo and registers the finalizer
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrMat(p *params, identifier string) {
-  m.mem = C.mlpackArmaPtrMat(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrMat(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
 // Allocates a C memory Pointer via cgo and registers the finalizer
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrUmat(p *params, identifier string) {
-  m.mem = C.mlpackArmaPtrUmat(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrUmat(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
 // Allocates a C memory Pointer via cgo and registers the finalizer
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrRow(p *params, identifier string) {
-  m.mem = C.mlpackArmaPtrRow(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrRow(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
 // Allocates a C memory Pointer via cgo and registers the finalizer
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrUrow(p *params, identifier string) {
-  m.mem = C.mlpackArmaPtrUrow(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrUrow(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
 // Allocates a C memory Pointer via cgo and registers the finalizer
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrCol(p *params, identifier string) {
-  m.mem = C.mlpackArmaPtrCol(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrCol(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
 // Allocates a C memory Pointer via cgo and registers the finalizer
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrUcol(p *params, identifier string) {
-  m.mem = C.mlpackArmaPtrUcol(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrUcol(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
@@ -86,161 +69,141 @@ func (m *mlpackArma) allocArmaPtrUcol(p *params, identifier string) {
 // in order to free the C memory once the input has been registered in Go.
 func (m *mlpackArma) allocArmaPtrMatWithInfo(p *params,
                                              identifier string) {
-  m.mem = C.mlpackArmaPtrMatWithInfoPtr(p.mem, C.CString(identifier))
+  m.mem = C.mlpackArmaPtrMatWithInfoPtr(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(m)
 }
 
-// Passes a Gonum matrix to C by using the underlying data from the Gonum matrix.
-func gonumToArmaMat(p *params, identifier string, m *mat.Dense, trans bool) {
-  // Get the number of elements in the Armadillo column.
-  r, c := m.Dims()
-  blas64General := m.RawMatrix()
-  data := blas64General.Data
+// Passes a Gonum matrix to C.
+func gonumToArmaMat(p *params, identifier string, m mat.Matrix, trans bool) {
+  r, c, data := packMatrix(p, identifier, m)
+  if data == nil {
+    return
+  }
 
-  // Pass pointer of the underlying matrix to mlpack.
+  // Pass pointer of the packed matrix to mlpack.
   ptr := unsafe.Pointer(&data[0])
-  C.mlpackToArmaMat(p.mem, C.CString(identifier), (*C.double)(ptr),
+  C.mlpackToArmaMat(p.mem, cIdentifier(identifier), (*C.double)(ptr),
       C.size_t(c), C.size_t(r), C.bool(trans))
 }
 
-// Passes a Gonum matrix to C by using the underlying data from the Gonum matrix.
-func gonumToArmaUmat(p *params, identifier string, m *mat.Dense) {
-  // Get the number of elements in the Armadillo column.
-  r, c := m.Dims()
-  blas64General := m.RawMatrix()
-  data := blas64General.Data
+// Passes a Gonum matrix to C.
+func gonumToArmaUmat(p *params, identifier string, m mat.Matrix) {
+  r, c, data := packMatrix(p, identifier, m)
+  if data == nil {
+    return
+  }
 
-  // Pass pointer of the underlying matrix to mlpack.
+  // Pass pointer of the packed matrix to mlpack.
   ptr := unsafe.Pointer(&data[0])
-  C.mlpackToArmaUmat(p.mem, C.CString(identifier), (*C.double)(ptr),
+  C.mlpackToArmaUmat(p.mem, cIdentifier(identifier), (*C.double)(ptr),
       C.size_t(c), C.size_t(r))
 }
 
-// Passes a Gonum matrix to C by using the underlying data from the Gonum matrix.
-func gonumToArmaRow(p *params, identifier string, m *mat.Dense) {
-  // Get the number of elements in the Armadillo column.
-  e, err := m.Dims()
-  if (err != 1 && e != 1){
-    panic("Given matrix must have a single column")
+// Passes a Gonum row or column vector to C as an Armadillo row.
+func gonumToArmaRow(p *params, identifier string, m mat.Matrix) {
+  data := packVector(p, identifier, m)
+  if data == nil {
+    return
   }
 
-  // Transpose if Column vector is given
-  if e == 1 {
-    m = mat.DenseCopyOf(m.T())
-    e = err
-  }
-
-  blas64General := m.RawMatrix()
-  data := blas64General.Data
-
-  // Pass pointer of the underlying matrix to mlpack.
+  // Pass pointer of the packed vector to mlpack.
   ptr := unsafe.Pointer(&data[0])
-  C.mlpackToArmaRow(p.mem, C.CString(identifier), (*C.double)(ptr),
-      C.size_t(e))
+  C.mlpackToArmaRow(p.mem, cIdentifier(identifier), (*C.double)(ptr),
+      C.size_t(len(data)))
 }
 
-// Passes a Gonum matrix to C by using the underlying data from the Gonum matrix.
-func gonumToArmaUrow(p *params, identifier string, m *mat.Dense) {
-  // Get the number of elements in the Armadillo column.
-  e, err := m.Dims()
-  if (err != 1 && e != 1){
-    panic("Given matrix must have a single column")
-  }
-
-  // Transpose if Column vector is given
-  if e == 1 {
-    m = mat.DenseCopyOf(m.T())
-    e = err
+// Passes a Gonum row or column vector to C as an Armadillo urow.
+func gonumToArmaUrow(p *params, identifier string, m mat.Matrix) {
+  data := packVector(p, identifier, m)
+  if data == nil {
+    return
   }
 
-  blas64General := m.RawMatrix()
-  data := blas64General.Data
-
-  // Pass pointer of the underlying matrix to mlpack.
+  // Pass pointer of the packed vector to mlpack.
   ptr := unsafe.Pointer(&data[0])
-  C.mlpackToArmaUrow(p.mem, C.CString(identifier), (*C.double)(ptr),
-      C.size_t(e))
+  C.mlpackToArmaUrow(p.mem, cIdentifier(identifier), (*C.double)(ptr),
+      C.size_t(len(data)))
 }
 
-// Passes a Gonum matrix to C by using the underlying data from the Gonum matrix.
-func gonumToArmaCol(p *params, identifier string, m *mat.Dense) {
-  // Get the number of elements in the Armadillo column.
-  err, e := m.Dims()
-  if (err != 1 && e != 1){
-    panic("Given matrix must have a single row")
-  }
-
-  // Transpose if Row vector is given
-  if e == 1 {
-    m = mat.DenseCopyOf(m.T())
-    e = err
+// Passes a Gonum row or column vector to C as an Armadillo column.
+func gonumToArmaCol(p *params, identifier string, m mat.Matrix) {
+  data := packVector(p, identifier, m)
+  if data == nil {
+    return
   }
 
-  blas64General := m.RawMatrix()
-  data := blas64General.Data
-
-  // Pass pointer of the underlying matrix to mlpack.
+  // Pass pointer of the packed vector to mlpack.
   ptr := unsafe.Pointer(&data[0])
-  C.mlpackToArmaCol(p.mem, C.CString(identifier), (*C.double)(ptr),
-      C.size_t(e))
+  C.mlpackToArmaCol(p.mem, cIdentifier(identifier), (*C.double)(ptr),
+      C.size_t(len(data)))
 }
 
-// Passes a Gonum matrix to C by using the underlying data from the Gonum matrix.
-func gonumToArmaUcol(p *params, identifier string, m *mat.Dense) {
-  // Get the number of elements in the Armadillo column.
-  err, e := m.Dims()
-  if (err != 1 && e != 1){
-    panic("Given matrix must have a single row")
+// Passes a Gonum row or column vector to C as an Armadillo ucol.
+func gonumToArmaUcol(p *params, identifier string, m mat.Matrix) {
+  data := packVector(p, identifier, m)
+  if data == nil {
+    return
   }
 
-  // Transpose if Row vector is given
-  if e == 1 {
-    m = mat.DenseCopyOf(m.T())
-    e = err
-  }
-
-  blas64General := m.RawMatrix()
-  data := blas64General.Data
-
-  // Pass pointer of the underlying matrix to mlpack.
+  // Pass pointer of the packed vector to mlpack.
   ptr := unsafe.Pointer(&data[0])
-  C.mlpackToArmaUcol(p.mem, C.CString(identifier), (*C.double)(ptr),
-      C.size_t(e))
+  C.mlpackToArmaUcol(p.mem, cIdentifier(identifier), (*C.double)(ptr),
+      C.size_t(len(data)))
 }
 
-// GonumToArmaMatWithInfo passes a gonum matrix with info to C by 
-// using it's gonums underlying blas64.
+// GonumToArmaMatWithInfo passes a gonum matrix with info to C.
 func gonumToArmaMatWithInfo(p *params,
                             identifier string,
                             m *matrixWithInfo) {
-  // Get the number of elements in the Armadillo column.
-  r, c := m.Data.Dims()
-  blas64General := m.Data.RawMatrix()
-  dataAndInfo := blas64General.Data
+  if m.Data == nil {
+    setError(p, identifier, "matrix is nil")
+    return
+  }
+  r, c, dataAndInfo := packMatrix(p, identifier, m.Data)
+  if dataAndInfo == nil {
+    return
+  }
   boolarray := m.Categoricals
-  // Pass pointer of the underlying matrix to mlpack.
+  if len(boolarray) != c {
+    setError(p, identifier,
+        "categoricals must have one entry for each column of the data")
+    return
+  }
+
+  // Pass pointer of the packed matrix to mlpack.
   boolptr := unsafe.Pointer(&boolarray[0])
   matptr := unsafe.Pointer(&dataAndInfo[0])
-  C.mlpackToArmaMatWithInfo(p.mem, C.CString(identifier),
+  C.mlpackToArmaMatWithInfo(p.mem, cIdentifier(identifier),
       (*C.bool)(boolptr), (*C.double)(matptr), C.size_t(c), C.size_t(r))
 }
 
+// Copies e elements of Armadillo memory into a new Go slice.  Output matrices
+// are owned by the Params object and released by cleanParams(), so results
+// must never alias them.
+func copyArmaMemory(ptr unsafe.Pointer, e int) []float64 {
+  data := make([]float64, e)
+  if ptr != nil && e > 0 {
+    copy(data, cFloat64s(ptr, e))
+  }
+  return data
+}
+
 // ArmaToGonum returns a gonum matrix based on the memory pointer
 // of an armadillo matrix.
 func (m *mlpackArma) armaToGonumMat(p *params,
                                     identifier string) *mat.Dense {
   // Get the number of elements in the Armadillo row.
-  c := int(C.mlpackNumRowMat(p.mem, C.CString(identifier)))
-  r := int(C.mlpackNumColMat(p.mem, C.CString(identifier)))
-  e := int(C.mlpackNumElemMat(p.mem, C.CString(identifier)))
+  c := int(C.mlpackNumRowMat(p.mem, cIdentifier(identifier)))
+  r := int(C.mlpackNumColMat(p.mem, cIdentifier(identifier)))
+  e := int(C.mlpackNumElemMat(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrMat(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-  if array != nil {
-    data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(r, c, data)
@@ -256,17 +219,16 @@ func (m *mlpackArma) armaToGonumMat(p *params,
 func (m *mlpackArma) armaToGonumArray(p *params,
                                       identifier string) (int, int, []float64) {
   // Get the number of elements in the Armadillo row.
-  c := int(C.mlpackNumRowMat(p.mem, C.CString(identifier)))
-  r := int(C.mlpackNumColMat(p.mem, C.CString(identifier)))
-  e := int(C.mlpackNumElemMat(p.mem, C.CString(identifier)))
+  c := int(C.mlpackNumRowMat(p.mem, cIdentifier(identifier)))
+  r := int(C.mlpackNumColMat(p.mem, cIdentifier(identifier)))
+  e := int(C.mlpackNumElemMat(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrMat(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-
-  data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  data := copyArmaMemory(m.mem, e)
   return r, c, data
 }
 
@@ -275,17 +237,17 @@ func (m *mlpackArma) armaToGonumArray(p *params,
 func (m *mlpackArma) armaToGonumUmat(p *params,
                                      identifier string) *mat.Dense {
   // Get the number of elements in the Armadillo row.
-  c := int(C.mlpackNumRowUmat(p.mem, C.CString(identifier)))
-  r := int(C.mlpackNumColUmat(p.mem, C.CString(identifier)))
-  e := int(C.mlpackNumElemUmat(p.mem, C.CString(identifier)))
+  c := int(C.mlpackNumRowUmat(p.mem, cIdentifier(identifier)))
+  r := int(C.mlpackNumColUmat(p.mem, cIdentifier(identifier)))
+  e := int(C.mlpackNumElemUmat(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrUmat(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-  if array != nil {
-    data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(r, c, data)
@@ -301,15 +263,15 @@ func (m *mlpackArma) armaToGonumUmat(p *params,
 func (m *mlpackArma) armaToGonumRow(p *params,
                                     identifier string) *mat.Dense {
   // Get the number of elements in the Armadillo row.
-  e := int(C.mlpackNumElemRow(p.mem, C.CString(identifier)))
+  e := int(C.mlpackNumElemRow(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrRow(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-  if array != nil {
-    data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(e, 1, data)
@@ -325,15 +287,15 @@ func (m *mlpackArma) armaToGonumRow(p *params,
 func (m *mlpackArma) armaToGonumUrow(p *params,
                                      identifier string) *mat.Dense {
   // Get the number of elements in the Armadillo row.
-  e := int(C.mlpackNumElemUrow(p.mem, C.CString(identifier)))
+  e := int(C.mlpackNumElemUrow(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrUrow(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-  if array != nil {
-    data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(e, 1, data)
@@ -348,15 +310,15 @@ func (m *mlpackArma) armaToGonumUrow(p *params,
 func (m *mlpackArma) armaToGonumCol(p *params,
                                     identifier string) *mat.Dense {
   // Get the number of elements in the Armadillo column.
-  e := int(C.mlpackNumElemCol(p.mem, C.CString(identifier)))
+  e := int(C.mlpackNumElemCol(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrCol(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-  if array != nil {
-    data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(1, e, data)
@@ -372,15 +334,15 @@ func (m *mlpackArma) armaToGonumCol(p *params,
 func (m *mlpackArma) armaToGonumUcol(p *params,
                                      identifier string) *mat.Dense {
   // Get the number of elements in the Armadillo column.
-  e := int(C.mlpackNumElemUcol(p.mem, C.CString(identifier)))
+  e := int(C.mlpackNumElemUcol(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrUcol(p, identifier)
 
-  // Convert pointer to slice of data, to then pass it to a gonum matrix.
-  array := (*[1<<30 - 1]float64)(m.mem)
-  if array != nil {
-    data := array[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(1, e, data)
@@ -396,16 +358,17 @@ func (m *mlpackArma) armaToGonumUcol(p *params,
 func (m *mlpackArma) armaToGonumMatWithInfo(p *params,
                                             identifier string) *mat.Dense {
   // Get number of rows, columns, and elements of the Armadillo matrix.
-  c := int(C.mlpackArmaMatWithInfoRows(p.mem, C.CString(identifier)))
-  r := int(C.mlpackArmaMatWithInfoCols(p.mem, C.CString(identifier)))
-  e := int(C.mlpackArmaMatWithInfoElements(p.mem, C.CString(identifier)))
+  c := int(C.mlpackArmaMatWithInfoRows(p.mem, cIdentifier(identifier)))
+  r := int(C.mlpackArmaMatWithInfoCols(p.mem, cIdentifier(identifier)))
+  e := int(C.mlpackArmaMatWithInfoElements(p.mem, cIdentifier(identifier)))
 
   // Allocate Go memory pointer to the armadillo matrix.
   m.allocArmaPtrMatWithInfo(p, identifier)
-  matarray := (*[1<<30 - 1]float64)(m.mem)
 
-  if matarray != nil {
-    data := matarray[:e]
+  // Copy the data into Go memory, since the Armadillo object is freed along
+  // with the Params object.
+  if m.mem != nil && e > 0 {
+    data := copyArmaMemory(m.mem, e)
 
     // Initialize result matrix.
     output := mat.NewDense(r, c, data)
@@ -0,0 +1,77 @@
+package mlpack
+
+import (
+  "context"
+  "errors"
+  "testing"
+  "time"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A backend that only implements Knn, and records the calls it gets.
+type fakeKnn struct {
+  UnavailableBackend
+  params []*KnnOptionalParam
+}
+
+func (f *fakeKnn) Knn(ctx context.Context, param *KnnOptionalParam) (
+    *mat.Dense, *mat.Dense, KNNModel, Timings, error) {
+  f.params = append(f.params, param)
+  if param.K < 0 {
+    return nil, nil, KNNModel{}, nil, errors.New("negative k")
+  }
+  distances := mat.NewDense(1, 1, []float64{0.5})
+  neighbors := mat.NewDense(1, 1, []float64{float64(param.K)})
+  return distances, neighbors, KNNModel{},
+      Timings{"total_time": time.Second}, nil
+}
+
+func TestSetBackend(t *testing.T) {
+  fake := &fakeKnn{}
+  prev := SetBackend(fake)
+  defer SetBackend(prev)
+
+  param := KnnOptions()
+  param.K = 3
+  distances, neighbors, _ := Knn(param)
+  if distances.At(0, 0) != 0.5 || neighbors.At(0, 0) != 3 {
+    t.Errorf("Knn() = %v, %v, want the outputs of the fake backend",
+        mat.Formatted(distances), mat.Formatted(neighbors))
+  }
+
+  _, _, _, err := KnnWithError(param)
+  if err != nil {
+    t.Errorf("KnnWithError() failed: %v", err)
+  }
+  _, _, _, timings, err := KnnWithTimers(param)
+  if err != nil || timings["total_time"] != time.Second {
+    t.Errorf("KnnWithTimers() = %v, %v, want the timings of the fake backend",
+        timings, err)
+  }
+  if len(fake.params) != 3 || fake.params[0] != param {
+    t.Errorf("the fake backend got %d calls, want 3 with the given options",
+        len(fake.params))
+  }
+
+  // Errors of the backend are returned as they are.
+  param.K = -1
+  if _, _, _, err := KnnWithError(param); err == nil ||
+      err.Error() != "negative k" {
+    t.Errorf("KnnWithError() = %v, want the error of the fake backend", err)
+  }
+
+  // Bindings the fake does not override reach UnavailableBackend.
+  _, err = PcaWithError(mat.NewDense(2, 2, nil), PcaOptions())
+  if err != ErrNotAvailable {
+    t.Errorf("PcaWithError() = %v, want ErrNotAvailable", err)
+  }
+
+  // Setting nil restores the default backend.
+  if b := SetBackend(nil); b != fake {
+    t.Errorf("SetBackend() returned %v, want the fake backend", b)
+  }
+  if b := currentBackend(); b != defaultBackend {
+    t.Errorf("SetBackend(nil) set %v, want the default backend", b)
+  }
+}
@@ -0,0 +1,32 @@
+package mlpack
+
+// ParamSpec describes a parameter of an mlpack binding, as reported by the
+// linked mlpack library.
+type ParamSpec struct {
+  // Name is the mlpack name of the parameter, e.g. "leaf_size".  The field of
+  // the options struct is the same name in CamelCase, e.g. LeafSize.
+  Name string
+  // Type is the C++ type of the parameter, e.g. "int", "double", "bool",
+  // "std::string", "std::vector<int>", "arma::mat", "arma::Row<size_t>" or,
+  // for models, the model type followed by "*", e.g. "KNNModel*".
+  Type string
+  // Description is the documentation of the parameter.
+  Description string
+  // Default is the default value, formatted as in the documentation, or empty
+  // if the parameter has none.
+  Default string
+  // Input is true for input parameters and false for outputs.
+  Input bool
+  // Required is true if the parameter must always be given.
+  Required bool
+}
+
+// BindingSpec describes an mlpack binding and its parameters.
+type BindingSpec struct {
+  // Name is the mlpack name of the binding, e.g. "knn".
+  Name string
+  // Description is the one-line description of the binding.
+  Description string
+  // Params lists the parameters of the binding, sorted by name.
+  Params []ParamSpec
+}
@@ -0,0 +1,59 @@
+// +build !nomlpack
+
+package mlpack
+
+import "sync"
+
+// mlpack keeps part of its state in process-wide globals: whether Log::Info
+// output is printed and where the log streams go.  Binding calls that leave
+// this state alone hold callLock for reading and run in parallel.  Calls that
+// change it for their own duration, by setting Verbose or a per-call Log, hold
+// it for writing, so they run alone and restore the state before any other
+// call starts.
+var callLock sync.RWMutex
+
+// The global settings shared by all calls are made once, before the first one.
+var setupOnce sync.Once
+
+// A bindingCall holds callLock for the duration of a binding call.
+type bindingCall struct {
+  exclusive bool
+  logHandle uintptr
+}
+
+// Acquires callLock for a binding call with the given options and sets up
+// mlpack's global state for it.  The call must be finished with end().
+func beginCall(verbose bool, out *LogOutput) *bindingCall {
+  setupOnce.Do(func() {
+    enableTimers()
+    disableBacktrace()
+    disableVerbose()
+  })
+
+  c := &bindingCall{exclusive: verbose || out != nil}
+  if !c.exclusive {
+    callLock.RLock()
+    return c
+  }
+  callLock.Lock()
+  if verbose {
+    enableVerbose()
+  }
+  if out != nil {
+    c.logHandle = redirectLog(out)
+  }
+  return c
+}
+
+// Restores mlpack's global state and releases callLock.
+func (c *bindingCall) end() {
+  if !c.exclusive {
+    callLock.RUnlock()
+    return
+  }
+  disableVerbose()
+  if c.logHandle != 0 {
+    restoreLog(c.logHandle)
+  }
+  callLock.Unlock()
+}
@@ -0,0 +1,57 @@
+/**
+ * @file capi/adaboost.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in adaboost.h for the adaboost binding.
+ */
+#include "go_params.hpp"
+#include "adaboost.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/adaboost/adaboost_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackAdaboost(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_adaboost);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<AdaBoostModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetAdaBoostModelPtr(void* params,
+                               const char* identifier,
+                               void* value)
+{
+  Get(params).params.Get<AdaBoostModel*>(identifier) = (AdaBoostModel*) value;
+}
+
+void* mlpackGetAdaBoostModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<AdaBoostModel*>(identifier);
+}
+
+char* mlpackSerializeAdaBoostModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((AdaBoostModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeAdaBoostModelPtr(const char* buffer,
+                                        size_t length,
+                                        int format)
+{
+  return DeserializeModel<AdaBoostModel>(buffer, length, format);
+}
+
+void mlpackDeleteAdaBoostModelPtr(void* ptr)
+{
+  delete (AdaBoostModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,57 @@
+/**
+ * @file capi/approx_kfn.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in approx_kfn.h for the approx_kfn binding.
+ */
+#include "go_params.hpp"
+#include "approx_kfn.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/approx_kfn/approx_kfn_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackApproxKfn(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_approx_kfn);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<ApproxKFNModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetApproxKFNModelPtr(void* params,
+                                const char* identifier,
+                                void* value)
+{
+  Get(params).params.Get<ApproxKFNModel*>(identifier) = (ApproxKFNModel*) value;
+}
+
+void* mlpackGetApproxKFNModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<ApproxKFNModel*>(identifier);
+}
+
+char* mlpackSerializeApproxKFNModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((ApproxKFNModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeApproxKFNModelPtr(const char* buffer,
+                                         size_t length,
+                                         int format)
+{
+  return DeserializeModel<ApproxKFNModel>(buffer, length, format);
+}
+
+void mlpackDeleteApproxKFNModelPtr(void* ptr)
+{
+  delete (ApproxKFNModel*) ptr;
+}
+
+} // extern "C"
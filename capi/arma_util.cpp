@@ -0,0 +1,241 @@
+/**
+ * @file capi/arma_util.cpp
+ *
+ * Implementation of the functions declared in arma_util.h.  Go passes every
+ * matrix in row-major order, which is the column-major layout of its
+ * transpose, so a matrix with one point per row arrives as the matrix with
+ * one point per column that mlpack expects.
+ *
+ * mlpack is free software; you may redistribute it and/or modify it under the
+ * terms of the 3-clause BSD license.  You should have received a copy of the
+ * 3-clause BSD license along with mlpack.  If not, see
+ * http://www.opensource.org/licenses/BSD-3-Clause for more information.
+ */
+#include "go_params.hpp"
+#include "arma_util.h"
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+// Converts an unsigned matrix to double, keeping the copy on the call so that
+// the returned memory stays valid until the Params object is cleaned.
+template<typename ObjectType>
+static void* ConvertedPtr(GoParams& p, const ObjectType& object)
+{
+  p.converted.push_back(arma::conv_to<arma::mat>::from(object));
+  return p.converted.back().memptr();
+}
+
+extern "C" {
+
+void mlpackToArmaMat(void* params,
+                     const char* identifier,
+                     double* mat,
+                     const size_t row,
+                     const size_t col,
+                     bool transpose)
+{
+  GoParams& p = Get(params);
+  // The memory belongs to Go and is only borrowed for the call, so it is
+  // copied.
+  arma::mat m(mat, row, col);
+  if (transpose)
+    arma::inplace_trans(m);
+  p.params.Get<arma::mat>(identifier) = std::move(m);
+  p.params.SetPassed(identifier);
+}
+
+void mlpackToArmaUmat(void* params,
+                      const char* identifier,
+                      double* mat,
+                      const size_t row,
+                      const size_t col)
+{
+  GoParams& p = Get(params);
+  const arma::mat m(mat, row, col, false, true);
+  p.params.Get<arma::Mat<size_t>>(identifier) =
+      arma::conv_to<arma::Mat<size_t>>::from(m);
+  p.params.SetPassed(identifier);
+}
+
+void mlpackToArmaRow(void* params,
+                     const char* identifier,
+                     double* rowvec,
+                     const size_t elem)
+{
+  GoParams& p = Get(params);
+  p.params.Get<arma::rowvec>(identifier) = arma::rowvec(rowvec, elem);
+  p.params.SetPassed(identifier);
+}
+
+void mlpackToArmaUrow(void* params,
+                      const char* identifier,
+                      double* rowvec,
+                      const size_t elem)
+{
+  GoParams& p = Get(params);
+  const arma::rowvec m(rowvec, elem, false, true);
+  p.params.Get<arma::Row<size_t>>(identifier) =
+      arma::conv_to<arma::Row<size_t>>::from(m);
+  p.params.SetPassed(identifier);
+}
+
+void mlpackToArmaCol(void* params,
+                     const char* identifier,
+                     double* colvec,
+                     const size_t elem)
+{
+  GoParams& p = Get(params);
+  p.params.Get<arma::vec>(identifier) = arma::vec(colvec, elem);
+  p.params.SetPassed(identifier);
+}
+
+void mlpackToArmaUcol(void* params,
+                      const char* identifier,
+                      double* colvec,
+                      const size_t elem)
+{
+  GoParams& p = Get(params);
+  const arma::vec m(colvec, elem, false, true);
+  p.params.Get<arma::Col<size_t>>(identifier) =
+      arma::conv_to<arma::Col<size_t>>::from(m);
+  p.params.SetPassed(identifier);
+}
+
+void* mlpackArmaPtrMat(void* params, const char* identifier)
+{
+  arma::mat& m = Get(params).params.Get<arma::mat>(identifier);
+  return m.n_elem == 0 ? NULL : m.memptr();
+}
+
+void* mlpackArmaPtrUmat(void* params, const char* identifier)
+{
+  GoParams& p = Get(params);
+  const arma::Mat<size_t>& m = p.params.Get<arma::Mat<size_t>>(identifier);
+  return m.n_elem == 0 ? NULL : ConvertedPtr(p, m);
+}
+
+void* mlpackArmaPtrRow(void* params, const char* identifier)
+{
+  arma::rowvec& m = Get(params).params.Get<arma::rowvec>(identifier);
+  return m.n_elem == 0 ? NULL : m.memptr();
+}
+
+void* mlpackArmaPtrUrow(void* params, const char* identifier)
+{
+  GoParams& p = Get(params);
+  const arma::Row<size_t>& m = p.params.Get<arma::Row<size_t>>(identifier);
+  return m.n_elem == 0 ? NULL : ConvertedPtr(p, m);
+}
+
+void* mlpackArmaPtrCol(void* params, const char* identifier)
+{
+  arma::vec& m = Get(params).params.Get<arma::vec>(identifier);
+  return m.n_elem == 0 ? NULL : m.memptr();
+}
+
+void* mlpackArmaPtrUcol(void* params, const char* identifier)
+{
+  GoParams& p = Get(params);
+  const arma::Col<size_t>& m = p.params.Get<arma::Col<size_t>>(identifier);
+  return m.n_elem == 0 ? NULL : ConvertedPtr(p, m);
+}
+
+size_t mlpackNumRowMat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::mat>(identifier).n_rows;
+}
+
+size_t mlpackNumColMat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::mat>(identifier).n_cols;
+}
+
+size_t mlpackNumElemMat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::mat>(identifier).n_elem;
+}
+
+size_t mlpackNumRowUmat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::Mat<size_t>>(identifier).n_rows;
+}
+
+size_t mlpackNumColUmat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::Mat<size_t>>(identifier).n_cols;
+}
+
+size_t mlpackNumElemUmat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::Mat<size_t>>(identifier).n_elem;
+}
+
+size_t mlpackNumElemRow(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::rowvec>(identifier).n_elem;
+}
+
+size_t mlpackNumElemUrow(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::Row<size_t>>(identifier).n_elem;
+}
+
+size_t mlpackNumElemCol(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::vec>(identifier).n_elem;
+}
+
+size_t mlpackNumElemUcol(void* params, const char* identifier)
+{
+  return Get(params).params.Get<arma::Col<size_t>>(identifier).n_elem;
+}
+
+void mlpackToArmaMatWithInfo(void* params,
+                             const char* identifier,
+                             const bool* dimensions,
+                             double* memptr,
+                             const size_t rows,
+                             const size_t cols)
+{
+  GoParams& p = Get(params);
+  data::DatasetInfo info(rows);
+  for (size_t i = 0; i < rows; ++i)
+  {
+    info.Type(i) = dimensions[i] ? data::Datatype::categorical :
+        data::Datatype::numeric;
+  }
+
+  typedef std::tuple<data::DatasetInfo, arma::mat> TupleType;
+  TupleType& t = p.params.Get<TupleType>(identifier);
+  std::get<0>(t) = std::move(info);
+  std::get<1>(t) = arma::mat(memptr, rows, cols);
+  p.params.SetPassed(identifier);
+}
+
+size_t mlpackArmaMatWithInfoElements(void* params, const char* identifier)
+{
+  typedef std::tuple<data::DatasetInfo, arma::mat> TupleType;
+  return std::get<1>(Get(params).params.Get<TupleType>(identifier)).n_elem;
+}
+
+size_t mlpackArmaMatWithInfoRows(void* params, const char* identifier)
+{
+  typedef std::tuple<data::DatasetInfo, arma::mat> TupleType;
+  return std::get<1>(Get(params).params.Get<TupleType>(identifier)).n_rows;
+}
+
+size_t mlpackArmaMatWithInfoCols(void* params, const char* identifier)
+{
+  typedef std::tuple<data::DatasetInfo, arma::mat> TupleType;
+  return std::get<1>(Get(params).params.Get<TupleType>(identifier)).n_cols;
+}
+
+void* mlpackArmaPtrMatWithInfoPtr(void* params, const char* identifier)
+{
+  typedef std::tuple<data::DatasetInfo, arma::mat> TupleType;
+  arma::mat& m = std::get<1>(Get(params).params.Get<TupleType>(identifier));
+  return m.n_elem == 0 ? NULL : m.memptr();
+}
+
+} // extern "C"
@@ -73,7 +73,9 @@ void mlpackToArmaUcol(void* params,
                       const size_t elem);
 
 /**
- * Return the memory pointer of an Armadillo mat object.
+ * Return the memory pointer of an Armadillo mat object.  Like every pointer
+ * returned by the functions below, the memory is owned by the Params object
+ * and released by mlpackCleanParams(), so the caller must copy it before then.
  */
 void* mlpackArmaPtrMat(void* params, const char* identifier);
 
@@ -105,52 +107,52 @@ void* mlpackArmaPtrUcol(void* params, const char* identifier);
 /**
  * Return the number of rows in a Armadillo mat.
  */
-int mlpackNumRowMat(void* params, const char* identifier);
+size_t mlpackNumRowMat(void* params, const char* identifier);
 
 /**
  * Return the number of columns in an Armadillo mat.
  */
-int mlpackNumColMat(void* params, const char* identifier);
+size_t mlpackNumColMat(void* params, const char* identifier);
 
 /**
  * Return the number of elements in an Armadillo mat.
  */
-int mlpackNumElemMat(void* params, const char* identifier);
+size_t mlpackNumElemMat(void* params, const char* identifier);
 
 /**
  * Return the number of rows in an Armadillo umat.
  */
-int mlpackNumRowUmat(void* params, const char* identifier);
+size_t mlpackNumRowUmat(void* params, const char* identifier);
 
 /**
  * Return the number of columns in an Armadillo umat.
  */
-int mlpackNumColUmat(void* params, const char* identifier);
+size_t mlpackNumColUmat(void* params, const char* identifier);
 
 /**
  * Return the number of elements in an Armadillo umat.
  */
-int mlpackNumElemUmat(void* params, const char* identifier);
+size_t mlpackNumElemUmat(void* params, const char* identifier);
 
 /**
  * Return the number of elements in an Armadillo row.
  */
-int mlpackNumElemRow(void* params, const char* identifier);
+size_t mlpackNumElemRow(void* params, const char* identifier);
 
 /**
  * Return the number of elements in an Armadillo urow.
  */
-int mlpackNumElemUrow(void* params, const char* identifier);
+size_t mlpackNumElemUrow(void* params, const char* identifier);
 
 /**
  * Return the number of elements in an Armadillo col.
  */
-int mlpackNumElemCol(void* params, const char* identifier);
+size_t mlpackNumElemCol(void* params, const char* identifier);
 
 /**
  * Return the number of elements in an Armadillo ucol.
  */
-int mlpackNumElemUcol(void* params, const char* identifier);
+size_t mlpackNumElemUcol(void* params, const char* identifier);
 
 /**
  * Call IO::SetParam<std::tuple<DatasetInfo, arma::mat>>().
@@ -165,21 +167,22 @@ void mlpackToArmaMatWithInfo(void* params,
 /**
  * Get the number of elements in a matrix with DatasetInfo parameter.
  */
-int mlpackArmaMatWithInfoElements(void* params, const char* identifier);
+size_t mlpackArmaMatWithInfoElements(void* params, const char* identifier);
 
 /**
  * Get the number of rows in a matrix with DatasetInfo parameter.
  */
-int mlpackArmaMatWithInfoRows(void* params, const char* identifier);
+size_t mlpackArmaMatWithInfoRows(void* params, const char* identifier);
 
 /**
  * Get the number of columns in a matrix with DatasetInfo parameter.
  */
-int mlpackArmaMatWithInfoCols(void* params, const char* identifier);
+size_t mlpackArmaMatWithInfoCols(void* params, const char* identifier);
 
 /**
- * Get a pointer to the memory of the matrix.  The calling function is expected
- * to own the memory.
+ * Get a pointer to the memory of the matrix.  The memory is owned by the Params
+ * object and released by mlpackCleanParams(), so the caller must copy it
+ * before then.
  */
 void* mlpackArmaPtrMatWithInfoPtr(void* params, const char* identifier);
 
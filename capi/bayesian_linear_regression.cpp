@@ -0,0 +1,57 @@
+/**
+ * @file capi/bayesian_linear_regression.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in bayesian_linear_regression.h for the bayesian_linear_regression binding.
+ */
+#include "go_params.hpp"
+#include "bayesian_linear_regression.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/bayesian_linear_regression/bayesian_linear_regression_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackBayesianLinearRegression(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_bayesian_linear_regression);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<BayesianLinearRegression<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetBayesianLinearRegressionPtr(void* params,
+                                          const char* identifier,
+                                          void* value)
+{
+  Get(params).params.Get<BayesianLinearRegression<>*>(identifier) = (BayesianLinearRegression<>*) value;
+}
+
+void* mlpackGetBayesianLinearRegressionPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<BayesianLinearRegression<>*>(identifier);
+}
+
+char* mlpackSerializeBayesianLinearRegressionPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((BayesianLinearRegression<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeBayesianLinearRegressionPtr(const char* buffer,
+                                                   size_t length,
+                                                   int format)
+{
+  return DeserializeModel<BayesianLinearRegression<>>(buffer, length, format);
+}
+
+void mlpackDeleteBayesianLinearRegressionPtr(void* ptr)
+{
+  delete (BayesianLinearRegression<>*) ptr;
+}
+
+} // extern "C"
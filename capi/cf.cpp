@@ -0,0 +1,74 @@
+/**
+ * @file capi/cf.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in cf.h for the cf binding.
+ */
+#include "go_params.hpp"
+#include "cf.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/cf/cf_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackCf(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_cf);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<CFModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetCFModelPtr(void* params,
+                         const char* identifier,
+                         void* value)
+{
+  Get(params).params.Get<CFModel*>(identifier) = (CFModel*) value;
+}
+
+void* mlpackGetCFModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<CFModel*>(identifier);
+}
+
+char* mlpackSerializeCFModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((CFModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeCFModelPtr(const char* buffer,
+                                  size_t length,
+                                  int format)
+{
+  return DeserializeModel<CFModel>(buffer, length, format);
+}
+
+void mlpackDeleteCFModelPtr(void* ptr)
+{
+  delete (CFModel*) ptr;
+}
+
+} // extern "C"
@@ -1,7 +1,7 @@
 /**
  * @file capi/cf.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the cf
  * binding to be called by Go.
  */
 #ifndef GO_cf_H
@@ -15,6 +15,10 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().  Once mlpackRequestAbort() is
+// called on the Params object the call fails, and its ensmallen optimizers stop
+// at their next step.
 extern void mlpackCf(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -29,6 +33,26 @@ extern void mlpackSetCFModelPtr(void* params,
 extern void* mlpackGetCFModelPtr(void* params,
                                             const char* identifier);
 
+// Serialize a model of type CFModel into a buffer allocated with malloc(),
+// storing its length in the given pointer.  The format is 0 for binary, 1 for
+// JSON and 2 for XML, and the archive has the same layout as models saved by
+// the mlpack command-line programs and Python bindings.  Returns NULL on
+// failure.
+extern char* mlpackSerializeCFModelPtr(void* ptr,
+                                              int format,
+                                              size_t* length);
+
+// Deserialize a model of type CFModel from the given buffer.  Returns NULL on
+// failure.
+extern void* mlpackDeserializeCFModelPtr(const char* buffer,
+                                                size_t length,
+                                                int format);
+
+// Delete a model of type CFModel.  Models returned by mlpackGetCFModelPtr() and
+// mlpackDeserializeCFModelPtr() are owned by the caller and are never deleted
+// when the Params object is cleaned.
+extern void mlpackDeleteCFModelPtr(void* ptr);
+
 
 #if defined(__cplusplus) || defined(c_plusplus)
 }
@@ -0,0 +1,23 @@
+/**
+ * @file capi/dbscan.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in dbscan.h for the dbscan binding.
+ */
+#include "go_params.hpp"
+#include "dbscan.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/dbscan/dbscan_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackDbscan(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_dbscan);
+}
+
+} // extern "C"
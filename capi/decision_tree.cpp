@@ -0,0 +1,57 @@
+/**
+ * @file capi/decision_tree.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in decision_tree.h for the decision_tree binding.
+ */
+#include "go_params.hpp"
+#include "decision_tree.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/decision_tree/decision_tree_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackDecisionTree(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_decision_tree);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<DecisionTreeModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetDecisionTreeModelPtr(void* params,
+                                   const char* identifier,
+                                   void* value)
+{
+  Get(params).params.Get<DecisionTreeModel*>(identifier) = (DecisionTreeModel*) value;
+}
+
+void* mlpackGetDecisionTreeModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<DecisionTreeModel*>(identifier);
+}
+
+char* mlpackSerializeDecisionTreeModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((DecisionTreeModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeDecisionTreeModelPtr(const char* buffer,
+                                            size_t length,
+                                            int format)
+{
+  return DeserializeModel<DecisionTreeModel>(buffer, length, format);
+}
+
+void mlpackDeleteDecisionTreeModelPtr(void* ptr)
+{
+  delete (DecisionTreeModel*) ptr;
+}
+
+} // extern "C"
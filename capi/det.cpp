@@ -0,0 +1,57 @@
+/**
+ * @file capi/det.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in det.h for the det binding.
+ */
+#include "go_params.hpp"
+#include "det.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/det/det_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackDet(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_det);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<DTree<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetDTreePtr(void* params,
+                       const char* identifier,
+                       void* value)
+{
+  Get(params).params.Get<DTree<>*>(identifier) = (DTree<>*) value;
+}
+
+void* mlpackGetDTreePtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<DTree<>*>(identifier);
+}
+
+char* mlpackSerializeDTreePtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((DTree<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeDTreePtr(const char* buffer,
+                                size_t length,
+                                int format)
+{
+  return DeserializeModel<DTree<>>(buffer, length, format);
+}
+
+void mlpackDeleteDTreePtr(void* ptr)
+{
+  delete (DTree<>*) ptr;
+}
+
+} // extern "C"
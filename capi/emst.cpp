@@ -0,0 +1,23 @@
+/**
+ * @file capi/emst.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in emst.h for the emst binding.
+ */
+#include "go_params.hpp"
+#include "emst.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/emst/emst_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackEmst(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_emst);
+}
+
+} // extern "C"
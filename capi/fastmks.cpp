@@ -0,0 +1,57 @@
+/**
+ * @file capi/fastmks.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in fastmks.h for the fastmks binding.
+ */
+#include "go_params.hpp"
+#include "fastmks.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/fastmks/fastmks_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackFastmks(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_fastmks);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<FastMKSModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetFastMKSModelPtr(void* params,
+                              const char* identifier,
+                              void* value)
+{
+  Get(params).params.Get<FastMKSModel*>(identifier) = (FastMKSModel*) value;
+}
+
+void* mlpackGetFastMKSModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<FastMKSModel*>(identifier);
+}
+
+char* mlpackSerializeFastMKSModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((FastMKSModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeFastMKSModelPtr(const char* buffer,
+                                       size_t length,
+                                       int format)
+{
+  return DeserializeModel<FastMKSModel>(buffer, length, format);
+}
+
+void mlpackDeleteFastMKSModelPtr(void* ptr)
+{
+  delete (FastMKSModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,52 @@
+/**
+ * @file capi/gmm_probability.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in gmm_probability.h for the gmm_probability binding.
+ */
+#include "go_params.hpp"
+#include "gmm_probability.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/gmm/gmm_probability_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackGmmProbability(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_gmm_probability);
+}
+
+void mlpackSetGMMPtr(void* params,
+                     const char* identifier,
+                     void* value)
+{
+  Get(params).params.Get<GMM*>(identifier) = (GMM*) value;
+}
+
+void* mlpackGetGMMPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<GMM*>(identifier);
+}
+
+char* mlpackSerializeGMMPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((GMM*) ptr, format, length);
+}
+
+void* mlpackDeserializeGMMPtr(const char* buffer,
+                              size_t length,
+                              int format)
+{
+  return DeserializeModel<GMM>(buffer, length, format);
+}
+
+void mlpackDeleteGMMPtr(void* ptr)
+{
+  delete (GMM*) ptr;
+}
+
+} // extern "C"
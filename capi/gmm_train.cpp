@@ -0,0 +1,74 @@
+/**
+ * @file capi/gmm_train.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in gmm_train.h for the gmm_train binding.
+ */
+#include "go_params.hpp"
+#include "gmm_train.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/gmm/gmm_train_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackGmmTrain(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_gmm_train);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<GMM>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetGMMPtr(void* params,
+                     const char* identifier,
+                     void* value)
+{
+  Get(params).params.Get<GMM*>(identifier) = (GMM*) value;
+}
+
+void* mlpackGetGMMPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<GMM*>(identifier);
+}
+
+char* mlpackSerializeGMMPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((GMM*) ptr, format, length);
+}
+
+void* mlpackDeserializeGMMPtr(const char* buffer,
+                              size_t length,
+                              int format)
+{
+  return DeserializeModel<GMM>(buffer, length, format);
+}
+
+void mlpackDeleteGMMPtr(void* ptr)
+{
+  delete (GMM*) ptr;
+}
+
+} // extern "C"
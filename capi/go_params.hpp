@@ -0,0 +1,370 @@
+/**
+ * @file capi/go_params.hpp
+ *
+ * The state of a binding call made from Go, shared by the hand-written
+ * io_util.cpp and arma_util.cpp and by the capi/<binding>.cpp files generated
+ * by mlpack-gen.  Every void* params handed to the C API points to a GoParams.
+ *
+ * mlpack is free software; you may redistribute it and/or modify it under the
+ * terms of the 3-clause BSD license.  You should have received a copy of the
+ * 3-clause BSD license along with mlpack.  If not, see
+ * http://www.opensource.org/licenses/BSD-3-Clause for more information.
+ */
+#ifndef MLPACK_BINDINGS_GO_MLPACK_GO_PARAMS_HPP
+#define MLPACK_BINDINGS_GO_MLPACK_GO_PARAMS_HPP
+
+#include <atomic>
+#include <cstdlib>
+#include <cstring>
+#include <initializer_list>
+#include <iostream>
+#include <list>
+#include <sstream>
+#include <string>
+#include <vector>
+
+#include "io_util.h"
+
+// The log streams of mlpack write to these streams, which forward to the
+// callback set with mlpackSetLogCallback().  The macros must be defined before
+// mlpack is included, in every file that includes it.
+std::ostream& mlpackGoInfoStream();
+std::ostream& mlpackGoWarnStream();
+#define MLPACK_COUT_STREAM mlpackGoInfoStream()
+#define MLPACK_CERR_STREAM mlpackGoWarnStream()
+
+#include <mlpack/core.hpp>
+#include <mlpack/core/util/io.hpp>
+#include <ensmallen.hpp>
+
+#ifdef _OPENMP
+  #include <omp.h>
+#endif
+
+namespace mlpack {
+namespace go {
+
+/**
+ * A binding call: the mlpack parameters, the error the call ended with, and
+ * the settings made through the C API for this call only.
+ */
+struct GoParams
+{
+  explicit GoParams(const std::string& bindingName) :
+      params(IO::Parameters(bindingName)),
+      hasError(false),
+      hasErrorParam(false),
+      numThreads(0),
+      callThreads(0),
+      abort(false),
+      progress(NULL),
+      progressHandle(0)
+  { }
+
+  util::Params params;
+
+  bool hasError;
+  std::string error;
+  bool hasErrorParam;
+  std::string errorParam;
+
+  // The value given to mlpackSetNumThreads(), and omp_get_max_threads() as
+  // seen inside the call.
+  int numThreads;
+  int callThreads;
+
+  std::atomic<bool> abort;
+  mlpackProgressCallback progress;
+  uintptr_t progressHandle;
+
+  // The parameters in the order of mlpackParamName(), and their formatted
+  // defaults; filled on first use.
+  std::vector<util::ParamData*> index;
+  std::vector<std::string> defaults;
+
+  // Copies of outputs converted to the types that Go reads: double for
+  // unsigned matrices, and long long for int vectors.
+  std::list<arma::mat> converted;
+  std::list<std::vector<long long>> convertedInts;
+};
+
+/**
+ * The timers of a binding call, and a snapshot of them taken on first use
+ * after the call.
+ */
+struct GoTimers
+{
+  GoTimers() : taken(false) { }
+
+  util::Timers timers;
+  bool taken;
+  std::vector<std::string> names;
+  std::vector<int64_t> microseconds;
+};
+
+inline GoParams& Get(void* params) { return *((GoParams*) params); }
+inline GoTimers& GetTimers(void* timers) { return *((GoTimers*) timers); }
+
+/**
+ * Return the call running on this thread, or NULL.  Optimizer callbacks use it
+ * to find the abort flag and the progress callback of their call.
+ */
+GoParams*& CurrentCall();
+
+/**
+ * Record the given error on the call, unless one is already recorded.
+ */
+inline void SetError(GoParams& p, const std::string& message)
+{
+  if (!p.hasError)
+  {
+    p.hasError = true;
+    p.error = message;
+  }
+}
+
+/**
+ * Run a binding on the given call: apply the thread count, make the call the
+ * current one of this thread, and turn any exception into an error.  A call
+ * that was asked to abort fails even if the program ran to the end.
+ */
+template<typename FunctionType>
+void RunBinding(void* params, void* timers, FunctionType binding)
+{
+  GoParams& p = Get(params);
+  if (p.abort)
+  {
+    SetError(p, "the call was aborted");
+    return;
+  }
+
+#ifdef _OPENMP
+  const int oldThreads = omp_get_max_threads();
+  if (p.numThreads > 0)
+    omp_set_num_threads(p.numThreads);
+  p.callThreads = omp_get_max_threads();
+#else
+  p.callThreads = 1;
+#endif
+
+  GoParams* outer = CurrentCall();
+  CurrentCall() = &p;
+  try
+  {
+    binding(p.params, GetTimers(timers).timers);
+  }
+  catch (std::exception& e)
+  {
+    SetError(p, e.what());
+  }
+  catch (...)
+  {
+    SetError(p, "unknown exception");
+  }
+  CurrentCall() = outer;
+  GetTimers(timers).timers.StopAllTimers();
+
+#ifdef _OPENMP
+  omp_set_num_threads(oldThreads);
+#endif
+
+  if (p.abort)
+    SetError(p, "the call was aborted");
+}
+
+/**
+ * An ensmallen callback that reports the progress of an optimizer to the
+ * callback set with mlpackSetProgressCallback(), and stops the optimizer once
+ * the call is asked to abort or the progress callback returns 0.  L-BFGS-like
+ * optimizers report every step; the others report every epoch.
+ */
+template<bool PerStep>
+class GoOptimizerCallback
+{
+ public:
+  GoOptimizerCallback() : call(CurrentCall()), iteration(0), objective(0) { }
+
+  template<typename OptimizerType, typename FunctionType, typename MatType>
+  bool Evaluate(OptimizerType&, FunctionType&, const MatType&,
+                const double value)
+  {
+    objective = value;
+    return Stop();
+  }
+
+  template<typename OptimizerType, typename FunctionType, typename MatType,
+           typename GradType>
+  bool EvaluateWithGradient(OptimizerType&, FunctionType&, const MatType&,
+                            const double value, const GradType&)
+  {
+    objective = value;
+    return Stop();
+  }
+
+  template<typename OptimizerType, typename FunctionType, typename MatType>
+  bool StepTaken(OptimizerType&, FunctionType&, MatType&)
+  {
+    return PerStep ? Report(objective) : Stop();
+  }
+
+  template<typename OptimizerType, typename FunctionType, typename MatType>
+  bool EndEpoch(OptimizerType&, FunctionType&, const MatType&,
+                const size_t, const double value)
+  {
+    return PerStep ? Stop() : Report(value);
+  }
+
+ private:
+  bool Stop() const { return call != NULL && call->abort; }
+
+  bool Report(const double value)
+  {
+    if (Stop())
+      return true;
+    if (call == NULL || call->progress == NULL)
+      return false;
+    return call->progress(call->progressHandle, ++iteration, value) == 0;
+  }
+
+  GoParams* call;
+  size_t iteration;
+  double objective;
+};
+
+/**
+ * An ensmallen optimizer that adds a GoOptimizerCallback to every
+ * optimization.  The binding files that report progress or can be aborted
+ * rename the optimizers of their program to these before including it.
+ */
+template<typename OptimizerType, bool PerStep>
+class GoOptimizer : public OptimizerType
+{
+ public:
+  using OptimizerType::OptimizerType;
+
+  template<typename FunctionType, typename MatType, typename... CallbackTypes>
+  typename MatType::elem_type Optimize(FunctionType& function,
+                                       MatType& coordinates,
+                                       CallbackTypes&&... callbacks)
+  {
+    return OptimizerType::Optimize(function, coordinates,
+        GoOptimizerCallback<PerStep>(),
+        std::forward<CallbackTypes>(callbacks)...);
+  }
+};
+
+/**
+ * Serialize the model into a buffer allocated with malloc(), in the layout of
+ * the models saved by the mlpack command-line programs.  Returns NULL on
+ * failure.
+ */
+template<typename ModelType>
+char* SerializeModel(ModelType* model, const int format, size_t* length)
+{
+  try
+  {
+    std::ostringstream stream;
+    // The archives write their last bytes when they are destroyed.
+    if (format == 0)
+    {
+      cereal::BinaryOutputArchive ar(stream);
+      ar(cereal::make_nvp("model", *model));
+    }
+    else if (format == 1)
+    {
+      cereal::JSONOutputArchive ar(stream);
+      ar(cereal::make_nvp("model", *model));
+    }
+    else if (format == 2)
+    {
+      cereal::XMLOutputArchive ar(stream);
+      ar(cereal::make_nvp("model", *model));
+    }
+    else
+    {
+      return NULL;
+    }
+
+    const std::string data = stream.str();
+    char* buffer = (char*) std::malloc(data.size() + 1);
+    if (buffer == NULL)
+      return NULL;
+    std::memcpy(buffer, data.data(), data.size());
+    *length = data.size();
+    return buffer;
+  }
+  catch (std::exception&)
+  {
+    return NULL;
+  }
+}
+
+/**
+ * Deserialize a model written by SerializeModel() or by the mlpack
+ * command-line programs.  Returns NULL on failure.
+ */
+template<typename ModelType>
+ModelType* DeserializeModel(const char* buffer,
+                            const size_t length,
+                            const int format)
+{
+  ModelType* model = new ModelType();
+  try
+  {
+    std::istringstream stream(std::string(buffer, length));
+    if (format == 0)
+    {
+      cereal::BinaryInputArchive ar(stream);
+      ar(cereal::make_nvp("model", *model));
+    }
+    else if (format == 1)
+    {
+      cereal::JSONInputArchive ar(stream);
+      ar(cereal::make_nvp("model", *model));
+    }
+    else if (format == 2)
+    {
+      cereal::XMLInputArchive ar(stream);
+      ar(cereal::make_nvp("model", *model));
+    }
+    else
+    {
+      delete model;
+      return NULL;
+    }
+    return model;
+  }
+  catch (std::exception&)
+  {
+    delete model;
+    return NULL;
+  }
+}
+
+/**
+ * Delete the model in the given output parameter after a failed call, since
+ * Go only takes the outputs of calls that succeed.  A model that is also one
+ * of the given inputs belongs to Go and is kept.
+ */
+template<typename ModelType>
+void DeleteOutputModel(GoParams& p,
+                       const char* output,
+                       std::initializer_list<const char*> inputs)
+{
+  ModelType*& model = p.params.Get<ModelType*>(output);
+  for (const char* input : inputs)
+  {
+    if (p.params.Get<ModelType*>(input) == model)
+    {
+      model = NULL;
+      return;
+    }
+  }
+  delete model;
+  model = NULL;
+}
+
+} // namespace go
+} // namespace mlpack
+
+#endif
@@ -0,0 +1,52 @@
+/**
+ * @file capi/hmm_loglik.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in hmm_loglik.h for the hmm_loglik binding.
+ */
+#include "go_params.hpp"
+#include "hmm_loglik.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/hmm/hmm_loglik_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackHmmLoglik(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_hmm_loglik);
+}
+
+void mlpackSetHMMModelPtr(void* params,
+                          const char* identifier,
+                          void* value)
+{
+  Get(params).params.Get<HMMModel*>(identifier) = (HMMModel*) value;
+}
+
+void* mlpackGetHMMModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<HMMModel*>(identifier);
+}
+
+char* mlpackSerializeHMMModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((HMMModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeHMMModelPtr(const char* buffer,
+                                   size_t length,
+                                   int format)
+{
+  return DeserializeModel<HMMModel>(buffer, length, format);
+}
+
+void mlpackDeleteHMMModelPtr(void* ptr)
+{
+  delete (HMMModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,57 @@
+/**
+ * @file capi/hoeffding_tree.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in hoeffding_tree.h for the hoeffding_tree binding.
+ */
+#include "go_params.hpp"
+#include "hoeffding_tree.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/hoeffding_trees/hoeffding_tree_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackHoeffdingTree(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_hoeffding_tree);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<HoeffdingTreeModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetHoeffdingTreeModelPtr(void* params,
+                                    const char* identifier,
+                                    void* value)
+{
+  Get(params).params.Get<HoeffdingTreeModel*>(identifier) = (HoeffdingTreeModel*) value;
+}
+
+void* mlpackGetHoeffdingTreeModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<HoeffdingTreeModel*>(identifier);
+}
+
+char* mlpackSerializeHoeffdingTreeModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((HoeffdingTreeModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeHoeffdingTreeModelPtr(const char* buffer,
+                                             size_t length,
+                                             int format)
+{
+  return DeserializeModel<HoeffdingTreeModel>(buffer, length, format);
+}
+
+void mlpackDeleteHoeffdingTreeModelPtr(void* ptr)
+{
+  delete (HoeffdingTreeModel*) ptr;
+}
+
+} // extern "C"
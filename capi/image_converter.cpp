@@ -0,0 +1,23 @@
+/**
+ * @file capi/image_converter.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in image_converter.h for the image_converter binding.
+ */
+#include "go_params.hpp"
+#include "image_converter.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/preprocess/image_converter_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackImageConverter(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_image_converter);
+}
+
+} // extern "C"
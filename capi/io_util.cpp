@@ -0,0 +1,448 @@
+/**
+ * @file capi/io_util.cpp
+ *
+ * Implementation of the functions declared in io_util.h.
+ *
+ * mlpack is free software; you may redistribute it and/or modify it under the
+ * terms of the 3-clause BSD license.  You should have received a copy of the
+ * 3-clause BSD license along with mlpack.  If not, see
+ * http://www.opensource.org/licenses/BSD-3-Clause for more information.
+ */
+#include "go_params.hpp"
+
+#include <cstdio>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+// The revision of the C API; see MLPACK_GO_CAPI in the Makefile.
+#ifndef MLPACK_GO_CAPI
+  #error "MLPACK_GO_CAPI must be defined"
+#endif
+
+namespace {
+
+// Whether new Timers objects record timings.
+std::atomic<bool> timingEnabled(false);
+
+// The destination set with mlpackSetLogCallback().
+std::atomic<mlpackLogCallback> logCallback(NULL);
+std::atomic<uintptr_t> logHandle(0);
+
+/**
+ * A stream buffer that passes everything written to it to the log callback,
+ * or to stdout or stderr if there is none.  It holds no data of its own, so
+ * nothing is pending when the destination changes.
+ */
+class GoLogBuffer : public std::streambuf
+{
+ public:
+  GoLogBuffer(const int stream, std::FILE* fallback) :
+      stream(stream), fallback(fallback) { }
+
+ protected:
+  std::streamsize xsputn(const char* s, std::streamsize n)
+  {
+    Write(s, (size_t) n);
+    return n;
+  }
+
+  int_type overflow(int_type c)
+  {
+    if (!traits_type::eq_int_type(c, traits_type::eof()))
+    {
+      const char ch = traits_type::to_char_type(c);
+      Write(&ch, 1);
+    }
+    return traits_type::not_eof(c);
+  }
+
+ private:
+  void Write(const char* s, const size_t n)
+  {
+    mlpackLogCallback callback = logCallback.load();
+    if (callback != NULL)
+      callback(logHandle.load(), stream, const_cast<char*>(s), n);
+    else
+      std::fwrite(s, 1, n, fallback);
+  }
+
+  int stream;
+  std::FILE* fallback;
+};
+
+} // namespace
+
+std::ostream& mlpackGoInfoStream()
+{
+  static GoLogBuffer buffer(MLPACK_LOG_INFO, stdout);
+  static std::ostream stream(&buffer);
+  return stream;
+}
+
+std::ostream& mlpackGoWarnStream()
+{
+  static GoLogBuffer buffer(MLPACK_LOG_WARN, stderr);
+  static std::ostream stream(&buffer);
+  return stream;
+}
+
+namespace mlpack {
+namespace go {
+
+GoParams*& CurrentCall()
+{
+  static thread_local GoParams* call = NULL;
+  return call;
+}
+
+} // namespace go
+} // namespace mlpack
+
+// Fills the parameter index and the formatted defaults of the call.
+static void IndexParams(GoParams& p)
+{
+  if (!p.index.empty())
+    return;
+
+  std::map<std::string, util::ParamData>& parameters = p.params.Parameters();
+  for (std::map<std::string, util::ParamData>::iterator it =
+      parameters.begin(); it != parameters.end(); ++it)
+  {
+    util::ParamData& d = it->second;
+    std::string value;
+    if (d.input && !d.required &&
+        p.params.functionMap[d.tname].count("DefaultParam") > 0)
+    {
+      p.params.functionMap[d.tname]["DefaultParam"](d, NULL, (void*) &value);
+    }
+    p.index.push_back(&d);
+    p.defaults.push_back(value);
+  }
+}
+
+extern "C" {
+
+void* mlpackGetParams(const char* bindingName)
+{
+  return new GoParams(bindingName);
+}
+
+void* mlpackGetTimers()
+{
+  GoTimers* t = new GoTimers();
+  t->timers.Enabled() = timingEnabled.load();
+  return t;
+}
+
+void mlpackCleanParams(void* params)
+{
+  delete (GoParams*) params;
+}
+
+void mlpackCleanTimers(void* timers)
+{
+  delete (GoTimers*) timers;
+}
+
+const char* mlpackGetErrorMessage(void* params)
+{
+  GoParams& p = Get(params);
+  return p.hasError ? p.error.c_str() : NULL;
+}
+
+const char* mlpackGetErrorParam(void* params)
+{
+  GoParams& p = Get(params);
+  return (p.hasError && p.hasErrorParam) ? p.errorParam.c_str() : NULL;
+}
+
+const char* mlpackVersion()
+{
+  static const std::string version =
+      std::to_string(MLPACK_VERSION_MAJOR) + "." +
+      std::to_string(MLPACK_VERSION_MINOR) + "." +
+      std::to_string(MLPACK_VERSION_PATCH) + "+" + MLPACK_GO_CAPI;
+  return version.c_str();
+}
+
+const char* mlpackBindingDescription(void* params)
+{
+  return Get(params).params.Doc().shortDescription.c_str();
+}
+
+size_t mlpackNumParams(void* params)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index.size();
+}
+
+const char* mlpackParamName(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index[index]->name.c_str();
+}
+
+const char* mlpackParamType(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index[index]->cppType.c_str();
+}
+
+const char* mlpackParamDescription(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index[index]->desc.c_str();
+}
+
+const char* mlpackParamDefault(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.defaults[index].c_str();
+}
+
+bool mlpackParamIsInput(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index[index]->input;
+}
+
+bool mlpackParamIsRequired(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index[index]->required;
+}
+
+bool mlpackParamNoTranspose(void* params, size_t index)
+{
+  GoParams& p = Get(params);
+  IndexParams(p);
+  return p.index[index]->noTranspose;
+}
+
+void mlpackRequestAbort(void* params)
+{
+  Get(params).abort = true;
+}
+
+void mlpackSetParamDouble(void* params, const char* identifier, double value)
+{
+  Get(params).params.Get<double>(identifier) = value;
+}
+
+void mlpackSetParamInt(void* params, const char* identifier, int value)
+{
+  Get(params).params.Get<int>(identifier) = value;
+}
+
+void mlpackSetParamFloat(void* params, const char* identifier, float value)
+{
+  Get(params).params.Get<float>(identifier) = value;
+}
+
+void mlpackSetParamBool(void* params, const char* identifier, bool value)
+{
+  Get(params).params.Get<bool>(identifier) = value;
+}
+
+void mlpackSetParamString(void* params,
+                          const char* identifier,
+                          const char* value)
+{
+  Get(params).params.Get<std::string>(identifier) = std::string(value);
+}
+
+void mlpackSetParamPtr(void* params, const char* identifier, double* ptr)
+{
+  Get(params).params.Get<double*>(identifier) = ptr;
+}
+
+void mlpackSetParamVectorInt(void* params,
+                             const char* identifier,
+                             const long long* ints,
+                             const size_t length)
+{
+  std::vector<int> vec(length);
+  for (size_t i = 0; i < length; ++i)
+    vec[i] = (int) ints[i];
+  Get(params).params.Get<std::vector<int>>(identifier) = std::move(vec);
+}
+
+void mlpackSetParamVectorStr(void* params,
+                             const char* identifier,
+                             const char* str,
+                             const size_t element)
+{
+  Get(params).params.Get<std::vector<std::string>>(identifier)[element] =
+      std::string(str);
+}
+
+void mlpackSetParamVectorStrLen(void* params,
+                                const char* identifier,
+                                const size_t length)
+{
+  std::vector<std::string>& vec =
+      Get(params).params.Get<std::vector<std::string>>(identifier);
+  vec.clear();
+  vec.resize(length);
+}
+
+bool mlpackHasParam(void* params, const char* identifier)
+{
+  return Get(params).params.Has(identifier);
+}
+
+const char* mlpackGetParamString(void* params, const char* identifier)
+{
+  return Get(params).params.Get<std::string>(identifier).c_str();
+}
+
+double mlpackGetParamDouble(void* params, const char* identifier)
+{
+  return Get(params).params.Get<double>(identifier);
+}
+
+float mlpackGetParamFloat(void* params, const char* identifier)
+{
+  return Get(params).params.Get<float>(identifier);
+}
+
+int mlpackGetParamInt(void* params, const char* identifier)
+{
+  return Get(params).params.Get<int>(identifier);
+}
+
+bool mlpackGetParamBool(void* params, const char* identifier)
+{
+  return Get(params).params.Get<bool>(identifier);
+}
+
+void* mlpackGetVecIntPtr(void* params, const char* identifier)
+{
+  GoParams& p = Get(params);
+  const std::vector<int>& vec = p.params.Get<std::vector<int>>(identifier);
+  p.convertedInts.push_back(std::vector<long long>(vec.begin(), vec.end()));
+  return p.convertedInts.back().data();
+}
+
+const char* mlpackGetVecStringPtr(void* params,
+                                  const char* identifier,
+                                  const size_t i)
+{
+  return Get(params).params.Get<std::vector<std::string>>(identifier)[i]
+      .c_str();
+}
+
+size_t mlpackVecIntSize(void* params, const char* identifier)
+{
+  return Get(params).params.Get<std::vector<int>>(identifier).size();
+}
+
+size_t mlpackVecStringSize(void* params, const char* identifier)
+{
+  return Get(params).params.Get<std::vector<std::string>>(identifier).size();
+}
+
+void mlpackSetPassed(void* params, const char* name)
+{
+  Get(params).params.SetPassed(name);
+}
+
+void mlpackEnableTimers()
+{
+  timingEnabled = true;
+}
+
+size_t mlpackNumTimers(void* timers)
+{
+  GoTimers& t = GetTimers(timers);
+  if (!t.taken)
+  {
+    std::map<std::string, std::chrono::microseconds> all =
+        t.timers.GetAllTimers();
+    for (std::map<std::string, std::chrono::microseconds>::iterator it =
+        all.begin(); it != all.end(); ++it)
+    {
+      t.names.push_back(it->first);
+      t.microseconds.push_back((int64_t) it->second.count());
+    }
+    t.taken = true;
+  }
+  return t.names.size();
+}
+
+const char* mlpackTimerName(void* timers, size_t index)
+{
+  return GetTimers(timers).names[index].c_str();
+}
+
+int64_t mlpackTimerMicroseconds(void* timers, size_t index)
+{
+  return GetTimers(timers).microseconds[index];
+}
+
+void mlpackDisableBacktrace()
+{
+  Log::Fatal.backtrace = false;
+}
+
+void mlpackEnableVerbose()
+{
+  Log::Info.ignoreInput = false;
+}
+
+void mlpackDisableVerbose()
+{
+  Log::Info.ignoreInput = true;
+}
+
+int mlpackGetDefaultNumThreads()
+{
+#ifdef _OPENMP
+  // Read once, before any call changes the value for its thread.
+  static const int threads = omp_get_max_threads();
+  return threads;
+#else
+  return 1;
+#endif
+}
+
+int mlpackGetCurrentNumThreads()
+{
+#ifdef _OPENMP
+  return omp_get_max_threads();
+#else
+  return 1;
+#endif
+}
+
+void mlpackSetNumThreads(void* params, int threads)
+{
+  Get(params).numThreads = threads;
+}
+
+void mlpackSetLogCallback(mlpackLogCallback callback, uintptr_t handle)
+{
+  // The handle is stored first, so a writer that sees the new callback also
+  // sees its handle.
+  logHandle = handle;
+  logCallback = callback;
+}
+
+void mlpackSetProgressCallback(void* params,
+                               mlpackProgressCallback callback,
+                               uintptr_t handle)
+{
+  GoParams& p = Get(params);
+  p.progress = callback;
+  p.progressHandle = handle;
+}
+
+} // extern "C"
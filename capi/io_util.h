@@ -41,6 +41,87 @@ void mlpackCleanParams(void* params);
  */
 void mlpackCleanTimers(void* timers);
 
+/**
+ * Return the message of the exception thrown during the last binding call made
+ * with the given Params object, or NULL if the call succeeded.  Every binding
+ * catches std::exception at the C boundary and records it here instead of
+ * letting it propagate into Go.
+ */
+const char* mlpackGetErrorMessage(void* params);
+
+/**
+ * Return the name of the parameter that the error recorded on the given Params
+ * object refers to, or NULL if the error is not tied to a single parameter.
+ */
+const char* mlpackGetErrorParam(void* params);
+
+/**
+ * Return the version of mlpack that the bindings were built from, followed by
+ * the revision of the C API in capi/, e.g. "4.7.0+go-capi.1".
+ */
+const char* mlpackVersion();
+
+/**
+ * Return the one-line description of the binding that the given Params object
+ * was created for.
+ */
+const char* mlpackBindingDescription(void* params);
+
+/**
+ * Return the number of parameters of the binding that the given Params object
+ * was created for.  The accessors below take an index less than this number;
+ * the strings they return are owned by the Params object.
+ */
+size_t mlpackNumParams(void* params);
+
+/**
+ * Return the name of the parameter with the given index, e.g. "leaf_size".
+ */
+const char* mlpackParamName(void* params, size_t index);
+
+/**
+ * Return the C++ type of the parameter with the given index, as mlpack
+ * reports it, e.g. "int", "arma::mat" or "KNNModel*".
+ */
+const char* mlpackParamType(void* params, size_t index);
+
+/**
+ * Return the documentation of the parameter with the given index.
+ */
+const char* mlpackParamDescription(void* params, size_t index);
+
+/**
+ * Return the default value of the parameter with the given index, formatted as
+ * in the binding documentation, or an empty string if it has none.
+ */
+const char* mlpackParamDefault(void* params, size_t index);
+
+/**
+ * Return whether the parameter with the given index is an input.
+ */
+bool mlpackParamIsInput(void* params, size_t index);
+
+/**
+ * Return whether the parameter with the given index is required.
+ */
+bool mlpackParamIsRequired(void* params, size_t index);
+
+/**
+ * Return whether the matrix parameter with the given index is passed without
+ * transposing it, i.e. with one point per row in the given memory.
+ */
+bool mlpackParamNoTranspose(void* params, size_t index);
+
+/**
+ * Ask the binding call running with the given Params object to stop as soon as
+ * possible.  The call then fails with an error recorded on the Params object.
+ * Bindings with long-running training stop their ensmallen optimizers at the
+ * next step; other work, such as EM iterations or tree building, runs to the
+ * end before the call notices the request.  This may be called from any
+ * thread while the call runs.
+ */
+void mlpackRequestAbort(void* params);
+
 /**
  * Set the double parameter to the given value.
  */
@@ -111,6 +192,11 @@ const char* mlpackGetParamString(void* params, const char* identifier);
  */
 double mlpackGetParamDouble(void* params, const char* identifier);
 
+/**
+ * Get the float parameter associated with specified identifier.
+ */
+float mlpackGetParamFloat(void* params, const char* identifier);
+
 /**
  * Get the int parameter associated with specified identifier.
  */
@@ -136,12 +222,12 @@ const char* mlpackGetVecStringPtr(void* params,
 /**
  * Get the vector<int> parameter's size.
  */
-int mlpackVecIntSize(void* params, const char* identifier);
+size_t mlpackVecIntSize(void* params, const char* identifier);
 
 /**
  * Get the vector<string> parameter's size.
  */
-int mlpackVecStringSize(void* params, const char* identifier);
+size_t mlpackVecStringSize(void* params, const char* identifier);
 
 /**
  * Set parameter as passed.
@@ -153,6 +239,23 @@ void mlpackSetPassed(void* params, const char* name);
  */
 void mlpackEnableTimers();
 
+/**
+ * Get the number of timers recorded on the given Timers object.
+ */
+size_t mlpackNumTimers(void* timers);
+
+/**
+ * Get the name of the timer with the given index, which must be less than
+ * mlpackNumTimers().  The string is owned by the Timers object.
+ */
+const char* mlpackTimerName(void* timers, size_t index);
+
+/**
+ * Get the total time recorded by the timer with the given index, in
+ * microseconds.
+ */
+int64_t mlpackTimerMicroseconds(void* timers, size_t index);
+
 /**
  * Disable backtraces.
  */
@@ -168,6 +271,67 @@ void mlpackEnableVerbose();
  */
 void mlpackDisableVerbose();
 
+/**
+ * Get the number of threads that OpenMP uses when a thread has not changed it,
+ * i.e. omp_get_max_threads() at startup, or 1 if mlpack was built without
+ * OpenMP.
+ */
+int mlpackGetDefaultNumThreads();
+
+/**
+ * Get omp_get_max_threads() on the calling thread, or 1 if mlpack was built
+ * without OpenMP.  Called from a progress callback, this is the number of
+ * threads that the running binding call may use.
+ */
+int mlpackGetCurrentNumThreads();
+
+/**
+ * Set the number of OpenMP threads that the binding call made with the given
+ * Params object may use.  omp_set_num_threads() only affects the thread that
+ * calls it, so the binding applies the value on the thread it runs on right
+ * before the call, and restores the previous value afterwards.  A value of 0
+ * leaves the OpenMP default in place.
+ */
+void mlpackSetNumThreads(void* params, int threads);
+
+/**
+ * Function that receives the output of mlpack's log streams.  The stream is
+ * MLPACK_LOG_INFO or MLPACK_LOG_WARN, and the message is not null-terminated.
+ */
+typedef void (*mlpackLogCallback)(uintptr_t handle, int stream, char* message,
+                                  size_t length);
+
+#define MLPACK_LOG_INFO 0
+#define MLPACK_LOG_WARN 1
+
+/**
+ * Route the output of Log::Info and Log::Warn to the given callback instead of
+ * stdout and stderr, or back to stdout and stderr if the callback is NULL.
+ * The handle is passed back to the callback unchanged.  Like the log streams
+ * themselves this setting is process-wide; output that is still pending is
+ * flushed to the previous destination before it changes.
+ */
+void mlpackSetLogCallback(mlpackLogCallback callback, uintptr_t handle);
+
+/**
+ * Function that receives the progress of an optimizer.  It is called after
+ * every iteration with the iteration number, starting at 1, and the current
+ * value of the objective.  It returns 0 to stop the optimization early, or any
+ * other value to continue.
+ */
+typedef int (*mlpackProgressCallback)(uintptr_t handle, size_t iteration,
+                                      double objective);
+
+/**
+ * Report the progress of the optimizer used by the binding call made with the
+ * given Params object to the given callback, through an ensmallen callback.
+ * The handle is passed back to the callback unchanged.  Stopping early is not
+ * an error; the binding returns the model as trained so far.
+ */
+void mlpackSetProgressCallback(void* params,
+                               mlpackProgressCallback callback,
+                               uintptr_t handle);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
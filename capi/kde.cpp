@@ -0,0 +1,57 @@
+/**
+ * @file capi/kde.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in kde.h for the kde binding.
+ */
+#include "go_params.hpp"
+#include "kde.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/kde/kde_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackKde(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_kde);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<KDEModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetKDEModelPtr(void* params,
+                          const char* identifier,
+                          void* value)
+{
+  Get(params).params.Get<KDEModel*>(identifier) = (KDEModel*) value;
+}
+
+void* mlpackGetKDEModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<KDEModel*>(identifier);
+}
+
+char* mlpackSerializeKDEModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((KDEModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeKDEModelPtr(const char* buffer,
+                                   size_t length,
+                                   int format)
+{
+  return DeserializeModel<KDEModel>(buffer, length, format);
+}
+
+void mlpackDeleteKDEModelPtr(void* ptr)
+{
+  delete (KDEModel*) ptr;
+}
+
+} // extern "C"
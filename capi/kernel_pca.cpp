@@ -0,0 +1,23 @@
+/**
+ * @file capi/kernel_pca.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in kernel_pca.h for the kernel_pca binding.
+ */
+#include "go_params.hpp"
+#include "kernel_pca.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/kernel_pca/kernel_pca_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackKernelPca(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_kernel_pca);
+}
+
+} // extern "C"
@@ -1,7 +1,7 @@
 /**
  * @file capi/kernel_pca.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the kernel_pca
  * binding to be called by Go.
  */
 #ifndef GO_kernel_pca_H
@@ -15,6 +15,8 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().
 extern void mlpackKernelPca(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -0,0 +1,57 @@
+/**
+ * @file capi/kfn.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in kfn.h for the kfn binding.
+ */
+#include "go_params.hpp"
+#include "kfn.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/neighbor_search/kfn_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackKfn(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_kfn);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<KFNModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetKFNModelPtr(void* params,
+                          const char* identifier,
+                          void* value)
+{
+  Get(params).params.Get<KFNModel*>(identifier) = (KFNModel*) value;
+}
+
+void* mlpackGetKFNModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<KFNModel*>(identifier);
+}
+
+char* mlpackSerializeKFNModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((KFNModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeKFNModelPtr(const char* buffer,
+                                   size_t length,
+                                   int format)
+{
+  return DeserializeModel<KFNModel>(buffer, length, format);
+}
+
+void mlpackDeleteKFNModelPtr(void* ptr)
+{
+  delete (KFNModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,23 @@
+/**
+ * @file capi/kmeans.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in kmeans.h for the kmeans binding.
+ */
+#include "go_params.hpp"
+#include "kmeans.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/kmeans/kmeans_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackKmeans(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_kmeans);
+}
+
+} // extern "C"
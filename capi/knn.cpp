@@ -0,0 +1,57 @@
+/**
+ * @file capi/knn.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in knn.h for the knn binding.
+ */
+#include "go_params.hpp"
+#include "knn.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/neighbor_search/knn_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackKnn(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_knn);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<KNNModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetKNNModelPtr(void* params,
+                          const char* identifier,
+                          void* value)
+{
+  Get(params).params.Get<KNNModel*>(identifier) = (KNNModel*) value;
+}
+
+void* mlpackGetKNNModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<KNNModel*>(identifier);
+}
+
+char* mlpackSerializeKNNModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((KNNModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeKNNModelPtr(const char* buffer,
+                                   size_t length,
+                                   int format)
+{
+  return DeserializeModel<KNNModel>(buffer, length, format);
+}
+
+void mlpackDeleteKNNModelPtr(void* ptr)
+{
+  delete (KNNModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,57 @@
+/**
+ * @file capi/krann.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in krann.h for the krann binding.
+ */
+#include "go_params.hpp"
+#include "krann.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/rann/krann_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackKrann(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_krann);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<RAModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetRAModelPtr(void* params,
+                         const char* identifier,
+                         void* value)
+{
+  Get(params).params.Get<RAModel*>(identifier) = (RAModel*) value;
+}
+
+void* mlpackGetRAModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<RAModel*>(identifier);
+}
+
+char* mlpackSerializeRAModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((RAModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeRAModelPtr(const char* buffer,
+                                  size_t length,
+                                  int format)
+{
+  return DeserializeModel<RAModel>(buffer, length, format);
+}
+
+void mlpackDeleteRAModelPtr(void* ptr)
+{
+  delete (RAModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,57 @@
+/**
+ * @file capi/lars.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in lars.h for the lars binding.
+ */
+#include "go_params.hpp"
+#include "lars.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/lars/lars_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLars(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_lars);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<LARS<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetLARSPtr(void* params,
+                      const char* identifier,
+                      void* value)
+{
+  Get(params).params.Get<LARS<>*>(identifier) = (LARS<>*) value;
+}
+
+void* mlpackGetLARSPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<LARS<>*>(identifier);
+}
+
+char* mlpackSerializeLARSPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((LARS<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeLARSPtr(const char* buffer,
+                               size_t length,
+                               int format)
+{
+  return DeserializeModel<LARS<>>(buffer, length, format);
+}
+
+void mlpackDeleteLARSPtr(void* ptr)
+{
+  delete (LARS<>*) ptr;
+}
+
+} // extern "C"
@@ -1,7 +1,7 @@
 /**
  * @file capi/lars.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the lars
  * binding to be called by Go.
  */
 #ifndef GO_lars_H
@@ -15,6 +15,8 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().
 extern void mlpackLars(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -29,6 +31,26 @@ extern void mlpackSetLARSPtr(void* params,
 extern void* mlpackGetLARSPtr(void* params,
                                             const char* identifier);
 
+// Serialize a model of type LARS<> into a buffer allocated with malloc(),
+// storing its length in the given pointer.  The format is 0 for binary, 1 for
+// JSON and 2 for XML, and the archive has the same layout as models saved by
+// the mlpack command-line programs and Python bindings.  Returns NULL on
+// failure.
+extern char* mlpackSerializeLARSPtr(void* ptr,
+                                              int format,
+                                              size_t* length);
+
+// Deserialize a model of type LARS<> from the given buffer.  Returns NULL on
+// failure.
+extern void* mlpackDeserializeLARSPtr(const char* buffer,
+                                                size_t length,
+                                                int format);
+
+// Delete a model of type LARS<>.  Models returned by mlpackGetLARSPtr() and
+// mlpackDeserializeLARSPtr() are owned by the caller and are never deleted when
+// the Params object is cleaned.
+extern void mlpackDeleteLARSPtr(void* ptr);
+
 
 #if defined(__cplusplus) || defined(c_plusplus)
 }
@@ -0,0 +1,57 @@
+/**
+ * @file capi/linear_regression.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in linear_regression.h for the linear_regression binding.
+ */
+#include "go_params.hpp"
+#include "linear_regression.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/linear_regression/linear_regression_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLinearRegression(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_linear_regression);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<LinearRegression<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetLinearRegressionPtr(void* params,
+                                  const char* identifier,
+                                  void* value)
+{
+  Get(params).params.Get<LinearRegression<>*>(identifier) = (LinearRegression<>*) value;
+}
+
+void* mlpackGetLinearRegressionPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<LinearRegression<>*>(identifier);
+}
+
+char* mlpackSerializeLinearRegressionPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((LinearRegression<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeLinearRegressionPtr(const char* buffer,
+                                           size_t length,
+                                           int format)
+{
+  return DeserializeModel<LinearRegression<>>(buffer, length, format);
+}
+
+void mlpackDeleteLinearRegressionPtr(void* ptr)
+{
+  delete (LinearRegression<>*) ptr;
+}
+
+} // extern "C"
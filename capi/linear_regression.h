@@ -1,7 +1,7 @@
 /**
  * @file capi/linear_regression.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the linear_regression
  * binding to be called by Go.
  */
 #ifndef GO_linear_regression_H
@@ -15,6 +15,8 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().
 extern void mlpackLinearRegression(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -29,6 +31,26 @@ extern void mlpackSetLinearRegressionPtr(void* params,
 extern void* mlpackGetLinearRegressionPtr(void* params,
                                             const char* identifier);
 
+// Serialize a model of type LinearRegression<> into a buffer allocated with
+// malloc(), storing its length in the given pointer.  The format is 0 for
+// binary, 1 for JSON and 2 for XML, and the archive has the same layout as
+// models saved by the mlpack command-line programs and Python bindings.
+// Returns NULL on failure.
+extern char* mlpackSerializeLinearRegressionPtr(void* ptr,
+                                              int format,
+                                              size_t* length);
+
+// Deserialize a model of type LinearRegression<> from the given buffer.
+// Returns NULL on failure.
+extern void* mlpackDeserializeLinearRegressionPtr(const char* buffer,
+                                                size_t length,
+                                                int format);
+
+// Delete a model of type LinearRegression<>.  Models returned by
+// mlpackGetLinearRegressionPtr() and mlpackDeserializeLinearRegressionPtr() are
+// owned by the caller and are never deleted when the Params object is cleaned.
+extern void mlpackDeleteLinearRegressionPtr(void* ptr);
+
 
 #if defined(__cplusplus) || defined(c_plusplus)
 }
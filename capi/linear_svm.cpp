@@ -0,0 +1,74 @@
+/**
+ * @file capi/linear_svm.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in linear_svm.h for the linear_svm binding.
+ */
+#include "go_params.hpp"
+#include "linear_svm.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/linear_svm/linear_svm_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLinearSvm(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_linear_svm);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<LinearSVMModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetLinearSVMModelPtr(void* params,
+                                const char* identifier,
+                                void* value)
+{
+  Get(params).params.Get<LinearSVMModel*>(identifier) = (LinearSVMModel*) value;
+}
+
+void* mlpackGetLinearSVMModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<LinearSVMModel*>(identifier);
+}
+
+char* mlpackSerializeLinearSVMModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((LinearSVMModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeLinearSVMModelPtr(const char* buffer,
+                                         size_t length,
+                                         int format)
+{
+  return DeserializeModel<LinearSVMModel>(buffer, length, format);
+}
+
+void mlpackDeleteLinearSVMModelPtr(void* ptr)
+{
+  delete (LinearSVMModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,40 @@
+/**
+ * @file capi/lmnn.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in lmnn.h for the lmnn binding.
+ */
+#include "go_params.hpp"
+#include "lmnn.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/lmnn/lmnn_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLmnn(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_lmnn);
+}
+
+} // extern "C"
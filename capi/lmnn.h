@@ -1,7 +1,7 @@
 /**
  * @file capi/lmnn.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the lmnn
  * binding to be called by Go.
  */
 #ifndef GO_lmnn_H
@@ -15,6 +15,11 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().  Once mlpackRequestAbort() is
+// called on the Params object the call fails, and its ensmallen optimizers stop
+// at their next step.  The optimizer reports its progress to the callback set
+// with mlpackSetProgressCallback(), if any.
 extern void mlpackLmnn(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -0,0 +1,57 @@
+/**
+ * @file capi/local_coordinate_coding.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in local_coordinate_coding.h for the local_coordinate_coding binding.
+ */
+#include "go_params.hpp"
+#include "local_coordinate_coding.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/local_coordinate_coding/local_coordinate_coding_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLocalCoordinateCoding(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_local_coordinate_coding);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<LocalCoordinateCoding<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetLocalCoordinateCodingPtr(void* params,
+                                       const char* identifier,
+                                       void* value)
+{
+  Get(params).params.Get<LocalCoordinateCoding<>*>(identifier) = (LocalCoordinateCoding<>*) value;
+}
+
+void* mlpackGetLocalCoordinateCodingPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<LocalCoordinateCoding<>*>(identifier);
+}
+
+char* mlpackSerializeLocalCoordinateCodingPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((LocalCoordinateCoding<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeLocalCoordinateCodingPtr(const char* buffer,
+                                                size_t length,
+                                                int format)
+{
+  return DeserializeModel<LocalCoordinateCoding<>>(buffer, length, format);
+}
+
+void mlpackDeleteLocalCoordinateCodingPtr(void* ptr)
+{
+  delete (LocalCoordinateCoding<>*) ptr;
+}
+
+} // extern "C"
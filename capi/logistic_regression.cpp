@@ -0,0 +1,74 @@
+/**
+ * @file capi/logistic_regression.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in logistic_regression.h for the logistic_regression binding.
+ */
+#include "go_params.hpp"
+#include "logistic_regression.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/logistic_regression/logistic_regression_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLogisticRegression(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_logistic_regression);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<LogisticRegression<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetLogisticRegressionPtr(void* params,
+                                    const char* identifier,
+                                    void* value)
+{
+  Get(params).params.Get<LogisticRegression<>*>(identifier) = (LogisticRegression<>*) value;
+}
+
+void* mlpackGetLogisticRegressionPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<LogisticRegression<>*>(identifier);
+}
+
+char* mlpackSerializeLogisticRegressionPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((LogisticRegression<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeLogisticRegressionPtr(const char* buffer,
+                                             size_t length,
+                                             int format)
+{
+  return DeserializeModel<LogisticRegression<>>(buffer, length, format);
+}
+
+void mlpackDeleteLogisticRegressionPtr(void* ptr)
+{
+  delete (LogisticRegression<>*) ptr;
+}
+
+} // extern "C"
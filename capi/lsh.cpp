@@ -0,0 +1,57 @@
+/**
+ * @file capi/lsh.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in lsh.h for the lsh binding.
+ */
+#include "go_params.hpp"
+#include "lsh.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/lsh/lsh_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackLsh(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_lsh);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<LSHSearch<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetLSHSearchPtr(void* params,
+                           const char* identifier,
+                           void* value)
+{
+  Get(params).params.Get<LSHSearch<>*>(identifier) = (LSHSearch<>*) value;
+}
+
+void* mlpackGetLSHSearchPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<LSHSearch<>*>(identifier);
+}
+
+char* mlpackSerializeLSHSearchPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((LSHSearch<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeLSHSearchPtr(const char* buffer,
+                                    size_t length,
+                                    int format)
+{
+  return DeserializeModel<LSHSearch<>>(buffer, length, format);
+}
+
+void mlpackDeleteLSHSearchPtr(void* ptr)
+{
+  delete (LSHSearch<>*) ptr;
+}
+
+} // extern "C"
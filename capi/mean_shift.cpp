@@ -0,0 +1,23 @@
+/**
+ * @file capi/mean_shift.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in mean_shift.h for the mean_shift binding.
+ */
+#include "go_params.hpp"
+#include "mean_shift.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/mean_shift/mean_shift_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackMeanShift(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_mean_shift);
+}
+
+} // extern "C"
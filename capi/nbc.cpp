@@ -0,0 +1,57 @@
+/**
+ * @file capi/nbc.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in nbc.h for the nbc binding.
+ */
+#include "go_params.hpp"
+#include "nbc.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/naive_bayes/nbc_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackNbc(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_nbc);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<NBCModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetNBCModelPtr(void* params,
+                          const char* identifier,
+                          void* value)
+{
+  Get(params).params.Get<NBCModel*>(identifier) = (NBCModel*) value;
+}
+
+void* mlpackGetNBCModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<NBCModel*>(identifier);
+}
+
+char* mlpackSerializeNBCModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((NBCModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeNBCModelPtr(const char* buffer,
+                                   size_t length,
+                                   int format)
+{
+  return DeserializeModel<NBCModel>(buffer, length, format);
+}
+
+void mlpackDeleteNBCModelPtr(void* ptr)
+{
+  delete (NBCModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,23 @@
+/**
+ * @file capi/nmf.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in nmf.h for the nmf binding.
+ */
+#include "go_params.hpp"
+#include "nmf.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/nmf/nmf_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackNmf(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_nmf);
+}
+
+} // extern "C"
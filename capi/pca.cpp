@@ -0,0 +1,23 @@
+/**
+ * @file capi/pca.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in pca.h for the pca binding.
+ */
+#include "go_params.hpp"
+#include "pca.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/pca/pca_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPca(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_pca);
+}
+
+} // extern "C"
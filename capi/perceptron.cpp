@@ -0,0 +1,57 @@
+/**
+ * @file capi/perceptron.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in perceptron.h for the perceptron binding.
+ */
+#include "go_params.hpp"
+#include "perceptron.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/perceptron/perceptron_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPerceptron(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_perceptron);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<PerceptronModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetPerceptronModelPtr(void* params,
+                                 const char* identifier,
+                                 void* value)
+{
+  Get(params).params.Get<PerceptronModel*>(identifier) = (PerceptronModel*) value;
+}
+
+void* mlpackGetPerceptronModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<PerceptronModel*>(identifier);
+}
+
+char* mlpackSerializePerceptronModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((PerceptronModel*) ptr, format, length);
+}
+
+void* mlpackDeserializePerceptronModelPtr(const char* buffer,
+                                          size_t length,
+                                          int format)
+{
+  return DeserializeModel<PerceptronModel>(buffer, length, format);
+}
+
+void mlpackDeletePerceptronModelPtr(void* ptr)
+{
+  delete (PerceptronModel*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,23 @@
+/**
+ * @file capi/preprocess_binarize.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in preprocess_binarize.h for the preprocess_binarize binding.
+ */
+#include "go_params.hpp"
+#include "preprocess_binarize.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/preprocess/preprocess_binarize_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPreprocessBinarize(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_preprocess_binarize);
+}
+
+} // extern "C"
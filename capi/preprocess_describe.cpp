@@ -0,0 +1,23 @@
+/**
+ * @file capi/preprocess_describe.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in preprocess_describe.h for the preprocess_describe binding.
+ */
+#include "go_params.hpp"
+#include "preprocess_describe.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/preprocess/preprocess_describe_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPreprocessDescribe(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_preprocess_describe);
+}
+
+} // extern "C"
@@ -0,0 +1,23 @@
+/**
+ * @file capi/preprocess_one_hot_encoding.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in preprocess_one_hot_encoding.h for the preprocess_one_hot_encoding binding.
+ */
+#include "go_params.hpp"
+#include "preprocess_one_hot_encoding.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/preprocess/preprocess_one_hot_encoding_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPreprocessOneHotEncoding(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_preprocess_one_hot_encoding);
+}
+
+} // extern "C"
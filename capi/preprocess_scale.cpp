@@ -0,0 +1,57 @@
+/**
+ * @file capi/preprocess_scale.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in preprocess_scale.h for the preprocess_scale binding.
+ */
+#include "go_params.hpp"
+#include "preprocess_scale.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/preprocess/preprocess_scale_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPreprocessScale(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_preprocess_scale);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<ScalingModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetScalingModelPtr(void* params,
+                              const char* identifier,
+                              void* value)
+{
+  Get(params).params.Get<ScalingModel*>(identifier) = (ScalingModel*) value;
+}
+
+void* mlpackGetScalingModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<ScalingModel*>(identifier);
+}
+
+char* mlpackSerializeScalingModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((ScalingModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeScalingModelPtr(const char* buffer,
+                                       size_t length,
+                                       int format)
+{
+  return DeserializeModel<ScalingModel>(buffer, length, format);
+}
+
+void mlpackDeleteScalingModelPtr(void* ptr)
+{
+  delete (ScalingModel*) ptr;
+}
+
+} // extern "C"
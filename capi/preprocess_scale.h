@@ -1,7 +1,7 @@
 /**
  * @file capi/preprocess_scale.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the preprocess_scale
  * binding to be called by Go.
  */
 #ifndef GO_preprocess_scale_H
@@ -15,6 +15,8 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().
 extern void mlpackPreprocessScale(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -29,6 +31,26 @@ extern void mlpackSetScalingModelPtr(void* params,
 extern void* mlpackGetScalingModelPtr(void* params,
                                             const char* identifier);
 
+// Serialize a model of type ScalingModel into a buffer allocated with malloc(),
+// storing its length in the given pointer.  The format is 0 for binary, 1 for
+// JSON and 2 for XML, and the archive has the same layout as models saved by
+// the mlpack command-line programs and Python bindings.  Returns NULL on
+// failure.
+extern char* mlpackSerializeScalingModelPtr(void* ptr,
+                                              int format,
+                                              size_t* length);
+
+// Deserialize a model of type ScalingModel from the given buffer.  Returns NULL
+// on failure.
+extern void* mlpackDeserializeScalingModelPtr(const char* buffer,
+                                                size_t length,
+                                                int format);
+
+// Delete a model of type ScalingModel.  Models returned by
+// mlpackGetScalingModelPtr() and mlpackDeserializeScalingModelPtr() are owned
+// by the caller and are never deleted when the Params object is cleaned.
+extern void mlpackDeleteScalingModelPtr(void* ptr);
+
 
 #if defined(__cplusplus) || defined(c_plusplus)
 }
@@ -0,0 +1,23 @@
+/**
+ * @file capi/preprocess_split.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in preprocess_split.h for the preprocess_split binding.
+ */
+#include "go_params.hpp"
+#include "preprocess_split.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/preprocess/preprocess_split_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackPreprocessSplit(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_preprocess_split);
+}
+
+} // extern "C"
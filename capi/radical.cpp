@@ -0,0 +1,23 @@
+/**
+ * @file capi/radical.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in radical.h for the radical binding.
+ */
+#include "go_params.hpp"
+#include "radical.h"
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/radical/radical_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackRadical(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_radical);
+}
+
+} // extern "C"
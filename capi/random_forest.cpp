@@ -0,0 +1,74 @@
+/**
+ * @file capi/random_forest.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in random_forest.h for the random_forest binding.
+ */
+#include "go_params.hpp"
+#include "random_forest.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/random_forest/random_forest_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackRandomForest(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_random_forest);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<RandomForestModel>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetRandomForestModelPtr(void* params,
+                                   const char* identifier,
+                                   void* value)
+{
+  Get(params).params.Get<RandomForestModel*>(identifier) = (RandomForestModel*) value;
+}
+
+void* mlpackGetRandomForestModelPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<RandomForestModel*>(identifier);
+}
+
+char* mlpackSerializeRandomForestModelPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((RandomForestModel*) ptr, format, length);
+}
+
+void* mlpackDeserializeRandomForestModelPtr(const char* buffer,
+                                            size_t length,
+                                            int format)
+{
+  return DeserializeModel<RandomForestModel>(buffer, length, format);
+}
+
+void mlpackDeleteRandomForestModelPtr(void* ptr)
+{
+  delete (RandomForestModel*) ptr;
+}
+
+} // extern "C"
@@ -1,7 +1,7 @@
 /**
  * @file capi/random_forest.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the random_forest
  * binding to be called by Go.
  */
 #ifndef GO_random_forest_H
@@ -15,6 +15,10 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().  Once mlpackRequestAbort() is
+// called on the Params object the call fails, and its ensmallen optimizers stop
+// at their next step.
 extern void mlpackRandomForest(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -29,6 +33,27 @@ extern void mlpackSetRandomForestModelPtr(void* params,
 extern void* mlpackGetRandomForestModelPtr(void* params,
                                             const char* identifier);
 
+// Serialize a model of type RandomForestModel into a buffer allocated with
+// malloc(), storing its length in the given pointer.  The format is 0 for
+// binary, 1 for JSON and 2 for XML, and the archive has the same layout as
+// models saved by the mlpack command-line programs and Python bindings.
+// Returns NULL on failure.
+extern char* mlpackSerializeRandomForestModelPtr(void* ptr,
+                                              int format,
+                                              size_t* length);
+
+// Deserialize a model of type RandomForestModel from the given buffer.  Returns
+// NULL on failure.
+extern void* mlpackDeserializeRandomForestModelPtr(const char* buffer,
+                                                size_t length,
+                                                int format);
+
+// Delete a model of type RandomForestModel.  Models returned by
+// mlpackGetRandomForestModelPtr() and mlpackDeserializeRandomForestModelPtr()
+// are owned by the caller and are never deleted when the Params object is
+// cleaned.
+extern void mlpackDeleteRandomForestModelPtr(void* ptr);
+
 
 #if defined(__cplusplus) || defined(c_plusplus)
 }
@@ -0,0 +1,74 @@
+/**
+ * @file capi/softmax_regression.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in softmax_regression.h for the softmax_regression binding.
+ */
+#include "go_params.hpp"
+#include "softmax_regression.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/softmax_regression/softmax_regression_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackSoftmaxRegression(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_softmax_regression);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<SoftmaxRegression<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetSoftmaxRegressionPtr(void* params,
+                                   const char* identifier,
+                                   void* value)
+{
+  Get(params).params.Get<SoftmaxRegression<>*>(identifier) = (SoftmaxRegression<>*) value;
+}
+
+void* mlpackGetSoftmaxRegressionPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<SoftmaxRegression<>*>(identifier);
+}
+
+char* mlpackSerializeSoftmaxRegressionPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((SoftmaxRegression<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeSoftmaxRegressionPtr(const char* buffer,
+                                            size_t length,
+                                            int format)
+{
+  return DeserializeModel<SoftmaxRegression<>>(buffer, length, format);
+}
+
+void mlpackDeleteSoftmaxRegressionPtr(void* ptr)
+{
+  delete (SoftmaxRegression<>*) ptr;
+}
+
+} // extern "C"
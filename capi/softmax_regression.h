@@ -1,7 +1,7 @@
 /**
  * @file capi/softmax_regression.h
  *
- * This is an autogenerated header file for functions specified to the %NAME%
+ * This is an autogenerated header file for functions specified to the softmax_regression
  * binding to be called by Go.
  */
 #ifndef GO_softmax_regression_H
@@ -15,6 +15,9 @@ extern "C"
 {
 #endif
 
+// Run the binding.  Any exception thrown by mlpack is caught and recorded on
+// the Params object; see mlpackGetErrorMessage().  The optimizer reports its
+// progress to the callback set with mlpackSetProgressCallback(), if any.
 extern void mlpackSoftmaxRegression(void* params, void* timers);
 
 // Any definitions of methods for dealing with model pointers will be put below
@@ -29,6 +32,27 @@ extern void mlpackSetSoftmaxRegressionPtr(void* params,
 extern void* mlpackGetSoftmaxRegressionPtr(void* params,
                                             const char* identifier);
 
+// Serialize a model of type SoftmaxRegression<> into a buffer allocated with
+// malloc(), storing its length in the given pointer.  The format is 0 for
+// binary, 1 for JSON and 2 for XML, and the archive has the same layout as
+// models saved by the mlpack command-line programs and Python bindings.
+// Returns NULL on failure.
+extern char* mlpackSerializeSoftmaxRegressionPtr(void* ptr,
+                                              int format,
+                                              size_t* length);
+
+// Deserialize a model of type SoftmaxRegression<> from the given buffer.
+// Returns NULL on failure.
+extern void* mlpackDeserializeSoftmaxRegressionPtr(const char* buffer,
+                                                size_t length,
+                                                int format);
+
+// Delete a model of type SoftmaxRegression<>.  Models returned by
+// mlpackGetSoftmaxRegressionPtr() and mlpackDeserializeSoftmaxRegressionPtr()
+// are owned by the caller and are never deleted when the Params object is
+// cleaned.
+extern void mlpackDeleteSoftmaxRegressionPtr(void* ptr);
+
 
 #if defined(__cplusplus) || defined(c_plusplus)
 }
@@ -0,0 +1,74 @@
+/**
+ * @file capi/sparse_coding.cpp
+ *
+ * This is an autogenerated file containing the implementation of the
+ * functions declared in sparse_coding.h for the sparse_coding binding.
+ */
+#include "go_params.hpp"
+#include "sparse_coding.h"
+
+// Report progress and check for aborts from the optimizers of the program.
+namespace ens {
+typedef mlpack::go::GoOptimizer<L_BFGS, true> GoL_BFGS;
+typedef mlpack::go::GoOptimizer<StandardSGD, false> GoStandardSGD;
+typedef mlpack::go::GoOptimizer<MiniBatchSGD, false> GoMiniBatchSGD;
+typedef mlpack::go::GoOptimizer<AMSGrad, false> GoAMSGrad;
+typedef mlpack::go::GoOptimizer<BBS_BB, false> GoBBS_BB;
+typedef mlpack::go::GoOptimizer<Adam, false> GoAdam;
+} // namespace ens
+
+#define L_BFGS GoL_BFGS
+#define StandardSGD GoStandardSGD
+#define MiniBatchSGD GoMiniBatchSGD
+#define AMSGrad GoAMSGrad
+#define BBS_BB GoBBS_BB
+#define Adam GoAdam
+
+#define BINDING_TYPE BINDING_TYPE_GO
+#include <mlpack/methods/sparse_coding/sparse_coding_main.cpp>
+
+using namespace mlpack;
+using namespace mlpack::go;
+
+extern "C" {
+
+void mlpackSparseCoding(void* params, void* timers)
+{
+  RunBinding(params, timers, mlpack_sparse_coding);
+  if (Get(params).hasError)
+  {
+    DeleteOutputModel<SparseCoding<>>(Get(params), "output_model",
+        { "input_model" });
+  }
+}
+
+void mlpackSetSparseCodingPtr(void* params,
+                              const char* identifier,
+                              void* value)
+{
+  Get(params).params.Get<SparseCoding<>*>(identifier) = (SparseCoding<>*) value;
+}
+
+void* mlpackGetSparseCodingPtr(void* params, const char* identifier)
+{
+  return Get(params).params.Get<SparseCoding<>*>(identifier);
+}
+
+char* mlpackSerializeSparseCodingPtr(void* ptr, int format, size_t* length)
+{
+  return SerializeModel((SparseCoding<>*) ptr, format, length);
+}
+
+void* mlpackDeserializeSparseCodingPtr(const char* buffer,
+                                       size_t length,
+                                       int format)
+{
+  return DeserializeModel<SparseCoding<>>(buffer, length, format);
+}
+
+void mlpackDeleteSparseCodingPtr(void* ptr)
+{
+  delete (SparseCoding<>*) ptr;
+}
+
+} // extern "C"
@@ -0,0 +1,84 @@
+package main
+
+import (
+  "fmt"
+  "sort"
+  "strings"
+)
+
+// Generates backend.go, which defines the Backend interface that the bindings
+// call, and the UnavailableBackend that fakes can embed.
+func backendFile(gens []*bindingGen) string {
+  sorted := append([]*bindingGen(nil), gens...)
+  sort.Slice(sorted, func(i, j int) bool {
+    return sorted[i].funcName < sorted[j].funcName
+  })
+
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString("package mlpack\n\nimport (\n  \"context\"\n  \"sync\"\n\n" +
+      "  \"gonum.org/v1/gonum/mat\"\n)\n\n")
+  out.WriteString(backendDoc)
+  out.WriteString("type Backend interface {\n")
+  for _, g := range sorted {
+    fmt.Fprintf(&out, "  %s\n", g.backendMethod())
+  }
+  out.WriteString("}\n\n")
+
+  out.WriteString(unavailableDoc)
+  out.WriteString("type UnavailableBackend struct{}\n")
+  for _, g := range sorted {
+    _, _, zeros := g.results()
+    fmt.Fprintf(&out, "\nfunc (UnavailableBackend) %s {\n  return %s\n}\n",
+        g.backendMethod(), list(zeros, []string{"nil", "ErrNotAvailable"}))
+  }
+  out.WriteString(backendTemplate)
+  return out.String()
+}
+
+const backendDoc = `/*
+  A Backend runs the bindings.  Each exported binding function, such as Knn or
+  RandomForestWithTimers, calls the method of the same name on the current
+  backend, which SetBackend replaces; validating the options is left to the
+  backend.  The default backend calls the mlpack libraries, or is an
+  UnavailableBackend in builds with the nomlpack tag.
+
+  The methods receive the positional arguments and options of the binding,
+  and return its outputs followed by the timings of the call.  Bindings that
+  cannot be aborted get context.Background().
+ */
+`
+
+const unavailableDoc = `/*
+  UnavailableBackend implements every method of Backend by returning
+  ErrNotAvailable.  Fakes can embed it and override only the bindings that a
+  test calls.
+ */
+`
+
+const backendTemplate = `
+// The backend that the bindings call.
+var backend = struct {
+  sync.RWMutex
+  b Backend
+}{b: defaultBackend}
+
+// SetBackend makes b the backend of all later binding calls and returns the
+// previous one.  A nil b restores the default backend.
+func SetBackend(b Backend) Backend {
+  if b == nil {
+    b = defaultBackend
+  }
+  backend.Lock()
+  defer backend.Unlock()
+  prev := backend.b
+  backend.b = b
+  return prev
+}
+
+func currentBackend() Backend {
+  backend.RLock()
+  defer backend.RUnlock()
+  return backend.b
+}
+`
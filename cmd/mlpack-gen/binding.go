@@ -0,0 +1,453 @@
+package main
+
+import (
+  "fmt"
+  "sort"
+  "strings"
+)
+
+// A goOption is a field of the options structs that is handled in Go and not
+// passed to mlpack.
+type goOption struct {
+  name string
+  typ string
+  doc string
+}
+
+var (
+  logOption = goOption{"Log", "*LogOutput", "Writers that receive the log " +
+      "output of this call, instead of the ones set with SetLogOutput().  " +
+      "No other binding call runs while a call with Log or Verbose set runs."}
+  threadsOption = goOption{"Threads", "int", "Maximum number of OpenMP " +
+      "threads for this call; 0 uses the value set with SetNumThreads()."}
+  progressOption = goOption{"Progress", "func(iter int, objective float64) bool",
+      "Called after every iteration of the optimizer with the iteration " +
+      "number and the objective; returning false stops training early.  It " +
+      "runs inside the call, which holds the locks of the package and of " +
+      "its input model, so it must not call any binding, model method or " +
+      "SetLogOutput(); doing so deadlocks."}
+)
+
+// bindingGen generates the Go file of a binding.
+type bindingGen struct {
+  *Binding
+  enums map[string]*Enum
+  // The Go name of the binding, e.g. "Knn".
+  funcName string
+  params []*param
+  byName map[string]*param
+  // Required inputs, which are passed as arguments, optional inputs, which are
+  // fields of the options struct, and outputs.
+  positional, options, outputs []*param
+  goOptions []goOption
+}
+
+func newBindingGen(b *Binding, enums map[string]*Enum) (*bindingGen, error) {
+  g := &bindingGen{
+    Binding: b,
+    enums: enums,
+    funcName: camelCase(b.Name),
+    byName: make(map[string]*param),
+  }
+  for i, p := range b.Params {
+    if i > 0 && b.Params[i-1].Name >= p.Name {
+      return nil, fmt.Errorf("%s: parameters are not sorted by name at %q",
+          b.Name, p.Name)
+    }
+    q, err := newParam(p)
+    if err != nil {
+      return nil, fmt.Errorf("%s: %v", b.Name, err)
+    }
+    if q.Enum != "" && enums[q.Enum] == nil {
+      return nil, fmt.Errorf("%s: parameter %q uses unknown enum %q", b.Name,
+          q.Name, q.Enum)
+    }
+    if len(q.Allowed) > 0 && q.Enum == "" {
+      return nil, fmt.Errorf("%s: parameter %q has allowed values but no enum",
+          b.Name, q.Name)
+    }
+    for _, v := range q.Allowed {
+      if g.enumConstant(q.Enum, v) == "" {
+        return nil, fmt.Errorf("%s: allowed value %q of parameter %q is not " +
+            "in enum %s", b.Name, v, q.Name, q.Enum)
+      }
+    }
+    g.params = append(g.params, q)
+    g.byName[q.Name] = q
+    switch {
+    case q.Output:
+      g.outputs = append(g.outputs, q)
+    case q.Required:
+      g.positional = append(g.positional, q)
+    default:
+      g.options = append(g.options, q)
+    }
+  }
+  g.goOptions = []goOption{logOption, threadsOption}
+  if b.Progress {
+    g.goOptions = append(g.goOptions, progressOption)
+  }
+  return g, nil
+}
+
+func (g *bindingGen) optionsType() string {
+  return g.funcName + "OptionalParam"
+}
+
+// Returns whether any parameter or return value has a gonum type.
+func (g *bindingGen) usesMat() bool {
+  for _, p := range g.params {
+    if p.isMatrix() && p.kind != kindMatWithInfo || p.Output && p.isMatrix() {
+      return true
+    }
+  }
+  return false
+}
+
+// Returns the import declarations of a file that uses the context package and,
+// if mat is true, gonum's mat package.
+func imports(mat bool) string {
+  if !mat {
+    return "import \"context\"\n\n"
+  }
+  return "import (\n  \"context\"\n\n  \"gonum.org/v1/gonum/mat\"\n)\n\n"
+}
+
+// Generates the Go file of the binding, which holds its options and the
+// functions that call the current backend.
+func (g *bindingGen) generate() (string, error) {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString("package mlpack\n\n")
+  out.WriteString(imports(g.usesMat()))
+
+  g.writeOptions(&out)
+  doc, err := g.doc()
+  if err != nil {
+    return "", err
+  }
+  out.WriteString(doc)
+  g.writeFuncs(&out)
+  return out.String(), nil
+}
+
+// Generates the Go file that implements the binding for the mlpack libraries.
+// It is left out of builds with the nomlpack tag, which need no cgo.
+func (g *bindingGen) native() string {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString(nativeConstraint + "package mlpack\n\n")
+  fmt.Fprintf(&out, "/*\n#cgo CFLAGS: -I./capi\n#cgo LDFLAGS: -L. " +
+      "-lmlpack_go_%s\n#include <capi/%s.h>\n#include <stdlib.h>\n*/\n" +
+      "import \"C\" \n\n", g.Name, g.Name)
+  out.WriteString(imports(g.usesMat()))
+
+  fmt.Fprintf(&out, "func init() {\n  registerBinding(%q, func(p *params, " +
+      "t *timers) {\n    C.mlpack%s(p.mem, t.mem)\n  })\n}\n\n", g.Name,
+      g.funcName)
+
+  if g.Abortable {
+    fmt.Fprintf(&out, "// Runs %s with the mlpack libraries, aborting it once " +
+        "ctx is done.\n", g.funcName)
+  } else {
+    fmt.Fprintf(&out, "// Runs %s with the mlpack libraries.\n", g.funcName)
+  }
+  fmt.Fprintf(&out, "func (nativeBackend) %s {\n", g.backendMethod())
+  _, vars, zeros := g.results()
+  g.writeBody(&out, g.funcName, zeros, vars)
+  return out.String()
+}
+
+// Writes the options struct, its constructor and its setters.
+func (g *bindingGen) writeOptions(out *strings.Builder) {
+  fmt.Fprintf(out, "type %s struct {\n", g.optionsType())
+  for _, p := range g.options {
+    fmt.Fprintf(out, "    %s %s\n", p.field(), p.inputType())
+  }
+  for _, o := range g.goOptions {
+    fmt.Fprintf(out, "    %s %s\n", o.name, o.typ)
+  }
+  out.WriteString("    passed paramSet\n}\n\n")
+
+  fmt.Fprintf(out, "func %sOptions() *%s {\n  return &%s{\n", g.funcName,
+      g.optionsType(), g.optionsType())
+  for _, p := range g.options {
+    if p.isVector() {
+      continue
+    }
+    fmt.Fprintf(out, "    %s: %s,\n", p.field(), p.defaultValue())
+  }
+  out.WriteString("  }\n}\n\n")
+
+  for _, p := range g.options {
+    if p.isPointer() {
+      continue
+    }
+    v := localName(p.Name)
+    fmt.Fprintf(out, "// Set%s sets %s and always passes it to mlpack.\n",
+        p.field(), p.field())
+    fmt.Fprintf(out, "func (p *%s) Set%s(%s %s) *%s {\n", g.optionsType(),
+        p.field(), v, p.inputType(), g.optionsType())
+    fmt.Fprintf(out, "  p.%s = %s\n  p.passed.mark(%q)\n  return p\n}\n\n",
+        p.field(), v, p.Name)
+  }
+}
+
+// Returns the documentation entry of a parameter.
+func docEntry(name, typ, desc string) string {
+  return hyphenate("   - " + name + " (" + typ + "): " + desc, "        ") +
+      "\n"
+}
+// Returns the doc comment of the binding.
+func (g *bindingGen) doc() (string, error) {
+  var out strings.Builder
+  out.WriteString("/*\n  " + hyphenate(g.LongDescription, "  ") + "\n\n")
+  for _, ex := range g.Examples {
+    text, err := g.example(ex)
+    if err != nil {
+      return "", fmt.Errorf("%s: %v", g.Name, err)
+    }
+    out.WriteString("  " + hyphenate(text, "  ") + "\n\n")
+  }
+
+  out.WriteString("  Input parameters:\n\n")
+  for _, p := range g.positional {
+    out.WriteString(docEntry(lowerCamelCase(p.Name), p.docType(),
+        p.Description))
+  }
+  entries := make(map[string]string)
+  for _, p := range g.options {
+    desc := p.Description
+    if d := p.docDefault(); d != "" {
+      desc += "  Default value " + d + "."
+    }
+    entries[p.field()] = docEntry(p.field(), p.docType(), desc)
+  }
+  for _, o := range g.goOptions {
+    typ := o.typ
+    if o.name == progressOption.name {
+      typ = "func(int, float64) bool"
+    }
+    entries[o.name] = docEntry(o.name, typ, o.doc)
+  }
+  names := make([]string, 0, len(entries))
+  for name := range entries {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+  for _, name := range names {
+    out.WriteString(entries[name])
+  }
+
+  if len(g.outputs) > 0 {
+    out.WriteString("\n  Output parameters:\n\n")
+  }
+  for _, p := range g.outputs {
+    desc := p.Description
+    if d := p.docDefault(); d != "" {
+      desc += "  Default value " + d + "."
+    }
+    out.WriteString(docEntry(lowerCamelCase(p.Name), p.docType(), desc))
+  }
+  out.WriteString("\n */\n")
+  return out.String(), nil
+}
+
+// Returns the argument list of the exported functions, without the context.
+func (g *bindingGen) args() string {
+  var args []string
+  for _, p := range g.positional {
+    args = append(args, localName(p.Name) + " " + p.inputType())
+  }
+  args = append(args, "param *" + g.optionsType())
+  return strings.Join(args, ", ")
+}
+
+// Returns the names of the positional arguments followed by "param".
+func (g *bindingGen) argNames() string {
+  var args []string
+  for _, p := range g.positional {
+    args = append(args, localName(p.Name))
+  }
+  args = append(args, "param")
+  return strings.Join(args, ", ")
+}
+
+// Returns the output types, the output variables and the zero values of the
+// outputs.
+func (g *bindingGen) results() (types, vars, zeros []string) {
+  for _, p := range g.outputs {
+    types = append(types, p.outputType())
+    vars = append(vars, localName(p.Name))
+    zeros = append(zeros, p.zero())
+  }
+  return types, vars, zeros
+}
+
+// Joins the given lists into a comma-separated list.
+func list(lists ...[]string) string {
+  var all []string
+  for _, l := range lists {
+    all = append(all, l...)
+  }
+  return strings.Join(all, ", ")
+}
+
+// Formats the result list of a function signature, with a leading space: none
+// for no results, a bare type for one, and a parenthesized list otherwise.
+func resultList(lists ...[]string) string {
+  var all []string
+  for _, l := range lists {
+    all = append(all, l...)
+  }
+  switch len(all) {
+  case 0:
+    return ""
+  case 1:
+    return " " + all[0]
+  }
+  return " (" + strings.Join(all, ", ") + ")"
+}
+
+// Formats text as a comment block with the binding's usual layout.  first is
+// the text up to the preferred line break, which is used if the first line
+// fits; otherwise the whole text is wrapped.
+func blockComment(first, rest string) string {
+  if len(first) + 2 <= 80 {
+    return "/*\n  " + first + "\n  " + rest + "\n */\n"
+  }
+  return "/*\n  " + hyphenate(first + " " + rest, "  ") + "\n */\n"
+}
+
+// Writes the plain, WithError, WithTimers and Context functions.
+func (g *bindingGen) writeFuncs(out *strings.Builder) {
+  types, vars, _ := g.results()
+  name := g.funcName
+  args := g.args()
+  argNames := g.argNames()
+
+  // The plain form panics on errors.
+  fmt.Fprintf(out, "func %s(%s)%s {\n", name, args, resultList(types))
+  if len(vars) == 0 {
+    fmt.Fprintf(out, "  if err := %sWithError(%s); err != nil {\n", name,
+        argNames)
+  } else {
+    fmt.Fprintf(out, "  %s := %sWithError(%s)\n  if err != nil {\n",
+        list(vars, []string{"err"}), name, argNames)
+  }
+  out.WriteString("    panic(err)\n  }\n")
+  if len(vars) > 0 {
+    fmt.Fprintf(out, "  return %s\n", list(vars))
+  }
+  out.WriteString("}\n\n")
+
+  out.WriteString(blockComment(name + "WithError is like " + name +
+      ", but returns a *BindingError instead of",
+      "panicking if mlpack reports an error."))
+  fmt.Fprintf(out, "func %sWithError(%s)%s {\n", name, args,
+      resultList(types, []string{"error"}))
+  fmt.Fprintf(out, "  %s := %sWithTimers(%s)\n", list(vars,
+      []string{"_", "err"}), name, argNames)
+  fmt.Fprintf(out, "  return %s\n}\n\n", list(vars, []string{"err"}))
+
+  out.WriteString(blockComment(name + "WithTimers is like " + name +
+      "WithError, but also returns the time that mlpack",
+      "spent in each of its timers during the call."))
+  fmt.Fprintf(out, "func %sWithTimers(%s)%s {\n", name, args,
+      resultList(types, []string{"Timings", "error"}))
+  fmt.Fprintf(out, "  return currentBackend().%s(context.Background(), %s)\n" +
+      "}\n", name, argNames)
+  if !g.Abortable {
+    return
+  }
+
+  out.WriteString("\n/*\n  " + hyphenate(name + "Context is like " + name +
+      "WithError, but returns ctx.Err() if ctx is cancelled or its " +
+      "deadline passes before the call completes.  Training with an " +
+      "ensmallen optimizer stops at its next step; other work runs to its " +
+      "end first.  All memory used by the call is released before it " +
+      "returns.", "  ") + "\n */\n")
+  fmt.Fprintf(out, "func %sContext(ctx context.Context, %s)%s {\n", name,
+      args, resultList(types, []string{"error"}))
+  fmt.Fprintf(out, "  %s := currentBackend().%s(ctx, %s)\n", list(vars,
+      []string{"_", "err"}), name, argNames)
+  fmt.Fprintf(out, "  return %s\n}\n", list(vars, []string{"err"}))
+}
+
+// Returns the signature of the binding's method in the Backend interface.
+func (g *bindingGen) backendMethod() string {
+  types, _, _ := g.results()
+  return fmt.Sprintf("%s(ctx context.Context, %s)%s", g.funcName, g.args(),
+      resultList(types, []string{"Timings", "error"}))
+}
+
+// Writes the body of the function that calls mlpack.
+func (g *bindingGen) writeBody(out *strings.Builder, name string, zeros,
+                               vars []string) {
+  errReturn := list(zeros, []string{"nil", "err"})
+  fmt.Fprintf(out, "  if err := param.Validate(); err != nil {\n" +
+      "    return %s\n  }\n", errReturn)
+  for _, p := range g.positional {
+    if p.Enum != "" {
+      fmt.Fprintf(out, "  if err := validate%s%s(%s); err != nil {\n" +
+          "    return %s\n  }\n", name, p.field(), localName(p.Name),
+          errReturn)
+    }
+  }
+  if g.Abortable {
+    fmt.Fprintf(out, "  if err := ctx.Err(); err != nil {\n" +
+        "    return %s\n  }\n", errReturn)
+  }
+  fmt.Fprintf(out, "\n  params := getParams(%q)\n  timers := getTimers()\n\n",
+      g.Name)
+  out.WriteString("  call := beginCall(param.Verbose, param.Log)\n" +
+      "  defer call.end()\n  setNumThreads(params, param.Threads)\n")
+  if g.Progress {
+    out.WriteString("  setProgress(params, param.Progress)\n")
+  }
+
+  for _, p := range g.positional {
+    out.WriteString("  // Detect if the parameter was passed; set if so.\n")
+    fmt.Fprintf(out, "  %s\n  setPassed(params, %q)\n\n",
+        p.setCall(localName(p.Name)), p.Name)
+  }
+  for _, p := range g.options {
+    out.WriteString("  // Detect if the parameter was passed; set if so.\n")
+    field := "param." + p.field()
+    cond := fmt.Sprintf("%s != %s || param.passed.has(%q)", field,
+        p.defaultValue(), p.Name)
+    if p.isPointer() {
+      cond = field + " != nil"
+    }
+    fmt.Fprintf(out, "  if %s {\n    %s\n    setPassed(params, %q)\n  }\n\n",
+        cond, p.setCall(field), p.Name)
+  }
+
+  out.WriteString("  // Mark all output options as passed.\n")
+  for _, p := range g.outputs {
+    fmt.Fprintf(out, "  setPassed(params, %q)\n", p.Name)
+  }
+  out.WriteString("\n  // Call the mlpack program.\n")
+  if g.Abortable {
+    fmt.Fprintf(out, "  aborted := false\n  if params.err == nil {\n" +
+        "    stop := watchContext(ctx, params)\n" +
+        "    C.mlpack%s(params.mem, timers.mem)\n    aborted = stop()\n  }\n" +
+        "  if err := contextError(ctx, aborted, getError(params)); " +
+        "err != nil {\n", name)
+  } else {
+    fmt.Fprintf(out, "  if params.err == nil {\n" +
+        "    C.mlpack%s(params.mem, timers.mem)\n  }\n" +
+        "  if err := getError(params); err != nil {\n", name)
+  }
+  fmt.Fprintf(out, "    // Clean memory.\n    cleanParams(params)\n" +
+      "    cleanTimers(timers)\n    return %s\n  }\n\n", errReturn)
+
+  out.WriteString("  // Initialize result variable and get output.\n")
+  for _, p := range g.outputs {
+    out.WriteString(p.getCall(localName(p.Name)))
+  }
+  out.WriteString("  // Clean memory.\n  cleanParams(params)\n" +
+      "  timings := getTimings(timers)\n  cleanTimers(timers)\n" +
+      "  // Return output(s).\n")
+  fmt.Fprintf(out, "  return %s\n}\n", list(vars, []string{"timings", "nil"}))
+}
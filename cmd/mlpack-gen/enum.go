@@ -0,0 +1,83 @@
+package main
+
+import (
+  "fmt"
+  "strings"
+)
+
+// Generates enums.go, which defines the enum types and their constants, and
+// the values that each option of an enum type accepts.
+func enumsFile(enums []Enum, gens []*bindingGen) string {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString("package mlpack\n\n" + lineComment("", "The types below " +
+      "give names to the values accepted by the string-valued options of " +
+      "the bindings.  Each options struct only accepts the subset of values " +
+      "that its binding supports; Validate() reports any other value before " +
+      "mlpack is called."))
+  for _, e := range enums {
+    out.WriteString("\n" + lineComment("", e.Doc))
+    fmt.Fprintf(&out, "type %s string\n\nconst (\n", e.Name)
+    for _, v := range e.Values {
+      if v.Doc != "" {
+        out.WriteString(lineComment("  ", v.Doc))
+      }
+      line := fmt.Sprintf("  %s %s = %q", v.Name, e.Name, v.Value)
+      if len(line) > 80 {
+        line = fmt.Sprintf("  %s %s =\n      %q", v.Name, e.Name, v.Value)
+      }
+      out.WriteString(line + "\n")
+    }
+    out.WriteString(")\n")
+  }
+
+  out.WriteString("\n" + lineComment("", "The values accepted by each " +
+      "option of an enum type, as checked by Validate().") + "var (\n")
+  for _, g := range gens {
+    for _, p := range g.params {
+      if len(p.Allowed) == 0 {
+        continue
+      }
+      values := make([]string, len(p.Allowed))
+      for i, v := range p.Allowed {
+        values[i] = "string(" + g.enumConstant(p.Enum, v) + ")"
+      }
+      out.WriteString(wrapList("  " + allowedVar(g.Name, p.Name) +
+          " = []string{", values, "}\n"))
+    }
+  }
+  out.WriteString(")\n")
+  return out.String()
+}
+
+// Returns the name of the variable that holds the allowed values of the given
+// parameter of a binding, e.g. "knnTreeTypeValues".
+func allowedVar(binding, param string) string {
+  return lowerCamelCase(binding) + camelCase(param) + "Values"
+}
+
+// Writes prefix, the comma-separated items and suffix, wrapping lines at 80
+// characters with a continuation indent of six spaces.
+func wrapList(prefix string, items []string, suffix string) string {
+  var out strings.Builder
+  line := prefix
+  for i, item := range items {
+    if i < len(items)-1 {
+      item += ","
+    } else {
+      item += suffix[:len(suffix)-1]
+    }
+    sep := " "
+    if i == 0 {
+      sep = ""
+    }
+    if len(line) + len(sep) + len(item) > 80 {
+      out.WriteString(line + "\n")
+      line = "      " + item
+    } else {
+      line += sep + item
+    }
+  }
+  out.WriteString(line + "\n")
+  return out.String()
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+  "encoding/json"
+  "fmt"
+  "strconv"
+  "strings"
+)
+
+// Renders an example as text, with each call of the binding written out as Go
+// code.
+func (g *bindingGen) example(ex Example) (string, error) {
+  var out strings.Builder
+  for _, seg := range ex {
+    if seg.Call == nil {
+      out.WriteString(seg.Text)
+      continue
+    }
+    call, err := g.call(seg.Call)
+    if err != nil {
+      return "", err
+    }
+    out.WriteString(call)
+  }
+  return out.String(), nil
+}
+
+// Returns the Go code for a call of the binding with the given arguments.
+func (g *bindingGen) call(args []Arg) (string, error) {
+  values := make(map[string][]string)
+  vars := make(map[string]bool)
+  var order []string
+  for _, arg := range args {
+    p, ok := g.byName[arg.Param]
+    if !ok {
+      return "", fmt.Errorf("example uses unknown parameter %q", arg.Param)
+    }
+    if p.isVector() && isString(arg.Value) {
+      // A string given to a vector option names a variable holding the whole
+      // vector.
+      var s string
+      json.Unmarshal(arg.Value, &s)
+      if _, ok := values[p.Name]; !ok {
+        order = append(order, p.Name)
+      }
+      values[p.Name] = []string{s}
+      vars[p.Name] = true
+      continue
+    }
+    v, err := g.argValue(p, arg.Value)
+    if err != nil {
+      return "", fmt.Errorf("example value of %q: %v", arg.Param, err)
+    }
+    if _, ok := values[p.Name]; !ok {
+      order = append(order, p.Name)
+    }
+    values[p.Name] = append(values[p.Name], v)
+  }
+
+  var out strings.Builder
+  fmt.Fprintf(&out, "// Initialize optional parameters for %s().\n", g.funcName)
+  fmt.Fprintf(&out, "param := mlpack.%sOptions()\n", g.funcName)
+  for _, name := range order {
+    p := g.byName[name]
+    if p.Output || p.Required {
+      continue
+    }
+    fmt.Fprintf(&out, "param.%s = %s\n", p.field(), g.joinValues(p,
+        values[name], vars[name]))
+  }
+  out.WriteString("\n")
+
+  var results []string
+  named := false
+  for _, p := range g.outputs {
+    if v, ok := values[p.Name]; ok {
+      results = append(results, v[0])
+      named = true
+    } else {
+      results = append(results, "_")
+    }
+  }
+  if named {
+    out.WriteString(strings.Join(results, ", ") + " := ")
+  }
+
+  var callArgs []string
+  for _, p := range g.positional {
+    v, ok := values[p.Name]
+    if !ok {
+      return "", fmt.Errorf("example does not give required parameter %q",
+          p.Name)
+    }
+    callArgs = append(callArgs, g.joinValues(p, v, vars[p.Name]))
+  }
+  callArgs = append(callArgs, "param")
+  fmt.Fprintf(&out, "mlpack.%s(%s)", g.funcName, strings.Join(callArgs, ", "))
+  return out.String(), nil
+}
+
+// Formats a single value given to a parameter in an example.
+func (g *bindingGen) argValue(p *param, raw json.RawMessage) (string, error) {
+  if p.kind == kindBool && len(raw) == 0 {
+    // A flag given without a value is set.
+    return "true", nil
+  }
+  if p.Output || p.isPointer() || p.kind == kindString ||
+     p.kind == kindVecString {
+    var s string
+    if err := json.Unmarshal(raw, &s); err != nil {
+      return "", err
+    }
+    switch {
+    case p.Output:
+      return s, nil
+    case p.kind == kindModel:
+      return "&" + s, nil
+    case p.isMatrix():
+      return s, nil
+    case p.Enum != "":
+      if c := g.enumConstant(p.Enum, s); c != "" {
+        return "mlpack." + c, nil
+      }
+    }
+    return strconv.Quote(s), nil
+  }
+  switch p.kind {
+  case kindBool:
+    var b bool
+    if err := json.Unmarshal(raw, &b); err != nil {
+      return "", err
+    }
+    return strconv.FormatBool(b), nil
+  default:
+    var n json.Number
+    if err := json.Unmarshal(raw, &n); err != nil {
+      return "", err
+    }
+    return n.String(), nil
+  }
+}
+
+// Combines the values given to a parameter; vector options may be given
+// several times, once for each element, or once as a variable.
+func (g *bindingGen) joinValues(p *param, values []string, isVar bool) string {
+  if isVar {
+    return values[0]
+  }
+  switch p.kind {
+  case kindVecInt:
+    return "[]int{" + strings.Join(values, ", ") + "}"
+  case kindVecString:
+    return "[]string{" + strings.Join(values, ", ") + "}"
+  }
+  return values[len(values)-1]
+}
+
+// Returns the name of the constant of the given enum type with the given
+// value, or "" if there is none.
+func (g *bindingGen) enumConstant(enum, value string) string {
+  e, ok := g.enums[enum]
+  if !ok {
+    return ""
+  }
+  for _, v := range e.Values {
+    if v.Value == value {
+      return v.Name
+    }
+  }
+  return ""
+}
+
+func isString(raw json.RawMessage) bool {
+  return len(raw) > 0 && raw[0] == '"'
+}
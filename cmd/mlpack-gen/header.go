@@ -0,0 +1,84 @@
+package main
+
+import (
+  "fmt"
+  "strings"
+)
+
+// Returns the model parameters of the binding, one for each C++ model type,
+// in the order of the parameters.
+func (g *bindingGen) models() []*param {
+  var models []*param
+  seen := make(map[string]bool)
+  for _, p := range g.params {
+    if p.kind == kindModel && !seen[p.model] {
+      seen[p.model] = true
+      models = append(models, p)
+    }
+  }
+  return models
+}
+
+// Generates the C header of the binding, which declares the functions of
+// the binding's C++ library.
+func (g *bindingGen) header() string {
+  var out strings.Builder
+  fmt.Fprintf(&out, "/**\n * @file capi/%s.h\n *\n" +
+      " * This is an autogenerated header file for functions specified to the" +
+      " %s\n * binding to be called by Go.\n */\n", g.Name, g.Name)
+  fmt.Fprintf(&out, "#ifndef GO_%s_H\n#define GO_%s_H\n\n", g.Name, g.Name)
+  out.WriteString("#include <stddef.h>\n\n" +
+      "#if defined(__cplusplus) || defined(c_plusplus)\n\n" +
+      "extern \"C\"\n{\n#endif\n\n")
+
+  doc := "Run the binding.  Any exception thrown by mlpack is caught and " +
+      "recorded on the Params object; see mlpackGetErrorMessage()."
+  if g.Abortable {
+    doc += "  Once mlpackRequestAbort() is called on the Params object " +
+        "the call fails, and its ensmallen optimizers stop at their next " +
+        "step."
+  }
+  if g.Progress {
+    doc += "  The optimizer reports its progress to the callback set with " +
+        "mlpackSetProgressCallback(), if any."
+  }
+  out.WriteString(lineComment("", doc))
+  fmt.Fprintf(&out, "extern void mlpack%s(void* params, void* timers);\n\n",
+      g.funcName)
+  out.WriteString("// Any definitions of methods for dealing with model " +
+      "pointers will be put below\n// this comment, if needed.\n\n")
+
+  for _, p := range g.models() {
+    m, t := p.model, p.cppModel
+    fmt.Fprintf(&out, "// Set the pointer to a %s parameter.\n", t)
+    fmt.Fprintf(&out, "extern void mlpackSet%sPtr(void* params,\n" +
+        "%sconst char* identifier,\n%svoid* value);\n\n", m,
+        strings.Repeat(" ", 43), strings.Repeat(" ", 43))
+    fmt.Fprintf(&out, "// Get the pointer to a %s parameter.\n", t)
+    fmt.Fprintf(&out, "extern void* mlpackGet%sPtr(void* params,\n" +
+        "%sconst char* identifier);\n\n", m, strings.Repeat(" ", 44))
+    out.WriteString(lineComment("", "Serialize a model of type " + t +
+        " into a buffer allocated with malloc(), storing its length in the " +
+        "given pointer.  The format is 0 for binary, 1 for JSON and 2 for " +
+        "XML, and the archive has the same layout as models saved by the " +
+        "mlpack command-line programs and Python bindings.  Returns NULL on " +
+        "failure."))
+    fmt.Fprintf(&out, "extern char* mlpackSerialize%sPtr(void* ptr,\n" +
+        "%sint format,\n%ssize_t* length);\n\n", m, strings.Repeat(" ", 46),
+        strings.Repeat(" ", 46))
+    out.WriteString(lineComment("", "Deserialize a model of type " + t +
+        " from the given buffer.  Returns NULL on failure."))
+    fmt.Fprintf(&out, "extern void* mlpackDeserialize%sPtr(const char* " +
+        "buffer,\n%ssize_t length,\n%sint format);\n\n", m,
+        strings.Repeat(" ", 48), strings.Repeat(" ", 48))
+    out.WriteString(lineComment("", "Delete a model of type " + t +
+        ".  Models returned by mlpackGet" + m + "Ptr() and mlpackDeserialize" +
+        m + "Ptr() are owned by the caller and are never deleted when the " +
+        "Params object is cleaned."))
+    fmt.Fprintf(&out, "extern void mlpackDelete%sPtr(void* ptr);\n\n", m)
+  }
+
+  out.WriteString("\n#if defined(__cplusplus) || defined(c_plusplus)\n}\n" +
+      "#endif\n\n#endif\n")
+  return out.String()
+}
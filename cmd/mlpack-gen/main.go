@@ -0,0 +1,132 @@
+// Command mlpack-gen generates the Go bindings of mlpack from the metadata of
+// its programs.
+//
+// The metadata directory holds package.json, which lists the bindings and
+// the enum types, and one <binding>.json file for each binding, which mirrors
+// the BINDING_* and PARAM_* declarations of the mlpack program.  From these,
+// mlpack-gen writes to the output directory:
+//
+//   - <binding>.go, the options struct and functions of each binding;
+//   - <binding>_native.go, the implementation of each binding that calls its
+//     mlpack library;
+//   - capi/<binding>.h and capi/<binding>.cpp, the C functions of each
+//     binding's library and their implementation on top of the mlpack
+//     program;
+//   - backend.go, the Backend interface with a method for each binding;
+//   - models.go, models_native.go, models_nomlpack.go and run_models.go, the
+//     Go type of each model;
+//   - enums.go, the enum types of the string options and the values that each
+//     option accepts.
+//
+// The _native.go files and run_models.go are left out of builds with the
+// nomlpack tag, and models_nomlpack.go is only part of those.
+//
+// Run it from the root of the repository with
+//
+//   go generate
+//
+// or go run ./cmd/mlpack-gen.  The -check flag reports the files that would
+// change instead of writing them.
+package main
+
+import (
+  "bytes"
+  "flag"
+  "fmt"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+)
+
+// The first line of every generated Go file.
+const generatedHeader = "// Code generated by mlpack-gen. DO NOT EDIT.\n\n"
+
+// The build constraints of the files that use the mlpack libraries through
+// cgo, and of the stubs that replace them in builds with the nomlpack tag.
+const (
+  nativeConstraint = "// +build !nomlpack\n\n"
+  stubConstraint = "// +build nomlpack\n\n"
+)
+
+func main() {
+  metadata := flag.String("metadata", "rel/metadata",
+      "directory containing the binding metadata")
+  outDir := flag.String("out", ".", "directory to write the bindings to")
+  check := flag.Bool("check", false,
+      "report files that are out of date instead of writing them")
+  flag.Parse()
+
+  files, err := generate(*metadata)
+  if err != nil {
+    fmt.Fprintln(os.Stderr, "mlpack-gen:", err)
+    os.Exit(1)
+  }
+
+  stale := false
+  for _, f := range files {
+    path := filepath.Join(*outDir, f.name)
+    old, err := ioutil.ReadFile(path)
+    if err == nil && bytes.Equal(old, f.data) {
+      continue
+    }
+    if *check {
+      fmt.Println(path)
+      stale = true
+      continue
+    }
+    if err := ioutil.WriteFile(path, f.data, 0644); err != nil {
+      fmt.Fprintln(os.Stderr, "mlpack-gen:", err)
+      os.Exit(1)
+    }
+  }
+  if stale {
+    os.Exit(1)
+  }
+}
+
+// A generated file, by its path relative to the output directory.
+type file struct {
+  name string
+  data []byte
+}
+
+// Generates all files from the metadata in the given directory.
+func generate(dir string) ([]file, error) {
+  pkg, bindings, err := loadMetadata(dir)
+  if err != nil {
+    return nil, err
+  }
+  enums := make(map[string]*Enum)
+  for i := range pkg.Enums {
+    enums[pkg.Enums[i].Name] = &pkg.Enums[i]
+  }
+
+  var files []file
+  var gens []*bindingGen
+  for _, b := range bindings {
+    g, err := newBindingGen(b, enums)
+    if err != nil {
+      return nil, err
+    }
+    gens = append(gens, g)
+    code, err := g.generate()
+    if err != nil {
+      return nil, err
+    }
+    files = append(files,
+        file{b.Name + ".go", []byte(code)},
+        file{b.Name + "_native.go", []byte(g.native())},
+        file{filepath.Join("capi", b.Name + ".h"), []byte(g.header())},
+        file{filepath.Join("capi", b.Name + ".cpp"), []byte(g.source())})
+  }
+
+  models := collectModels(gens)
+  files = append(files,
+      file{"backend.go", []byte(backendFile(gens))},
+      file{"models.go", []byte(modelsFile(models))},
+      file{"models_native.go", []byte(nativeModelsFile(gens, models))},
+      file{"models_nomlpack.go", []byte(stubModelsFile(models))},
+      file{"run_models.go", []byte(runModelsFile(models))},
+      file{"enums.go", []byte(enumsFile(pkg.Enums, gens))})
+  return files, nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+  "encoding/json"
+  "fmt"
+  "io/ioutil"
+  "path/filepath"
+)
+
+// Package is the metadata shared by all bindings, read from package.json.
+type Package struct {
+  // Bindings lists the names of all bindings, in the order in which mlpack
+  // builds them.  Models are emitted in the order in which they first appear
+  // in these bindings.
+  Bindings []string `json:"bindings"`
+  // Enums are the string enum types used by the bindings.
+  Enums []Enum `json:"enums"`
+}
+
+// Enum is a string type with named constants for the values an option
+// accepts.
+type Enum struct {
+  Name string `json:"name"`
+  Doc string `json:"doc"`
+  Values []EnumValue `json:"values"`
+}
+
+// EnumValue is one constant of an Enum.
+type EnumValue struct {
+  Name string `json:"name"`
+  Value string `json:"value"`
+  Doc string `json:"doc,omitempty"`
+}
+
+// Binding is the metadata of one mlpack binding, read from <name>.json.  It
+// mirrors the BINDING_* and PARAM_* declarations of the mlpack program.
+type Binding struct {
+  // Name is the mlpack name of the binding, e.g. "knn".
+  Name string `json:"name"`
+  // Program is the path of the mlpack program relative to the src directory
+  // of the mlpack sources, e.g. "mlpack/methods/neighbor_search/knn_main.cpp".
+  Program string `json:"program"`
+  // LongDescription is the BINDING_LONG_DESC text.
+  LongDescription string `json:"longDescription"`
+  // Examples are the BINDING_EXAMPLE texts.
+  Examples []Example `json:"examples,omitempty"`
+  // Abortable is true if the binding can be stopped with mlpackRequestAbort(),
+  // which gives it a Context form.
+  Abortable bool `json:"abortable,omitempty"`
+  // Progress is true if the binding reports optimizer progress to the callback
+  // set with mlpackSetProgressCallback().
+  Progress bool `json:"progress,omitempty"`
+  // Params are the parameters of the binding, sorted by name.
+  Params []Param `json:"params"`
+}
+
+// Param is one PARAM_* declaration of a binding.
+type Param struct {
+  // Name is the mlpack name, e.g. "leaf_size".
+  Name string `json:"name"`
+  // Type is the C++ type, as reported by mlpackParamType().
+  Type string `json:"type"`
+  Description string `json:"description"`
+  // Default is the default value as mlpack prints it, if there is one.
+  Default *string `json:"default,omitempty"`
+  Required bool `json:"required,omitempty"`
+  Output bool `json:"output,omitempty"`
+  // NoTranspose is true for matrices that mlpack takes without transposing
+  // them.
+  NoTranspose bool `json:"noTranspose,omitempty"`
+  // Enum is the name of the Enum type used for a string option, if any.
+  Enum string `json:"enum,omitempty"`
+  // Allowed are the values of the Enum that the binding accepts, in the order
+  // in which Validate() lists them.
+  Allowed []string `json:"allowed,omitempty"`
+}
+
+// An Example is a sequence of text and program calls, as built with
+// PRINT_CALL() in mlpack.
+type Example []Segment
+
+// A Segment is either text or a call of the binding.
+type Segment struct {
+  Text string `json:"text,omitempty"`
+  Call []Arg `json:"call,omitempty"`
+}
+
+// Arg is one parameter given to a call in an example.  Inputs have the value
+// to pass, and outputs the name of the variable that receives them.  Vector
+// options are given once for each element.
+type Arg struct {
+  Param string `json:"param"`
+  Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Reads package.json and the metadata of every binding it lists from dir.
+func loadMetadata(dir string) (*Package, []*Binding, error) {
+  var pkg Package
+  if err := readJSON(filepath.Join(dir, "package.json"), &pkg); err != nil {
+    return nil, nil, err
+  }
+  bindings := make([]*Binding, 0, len(pkg.Bindings))
+  for _, name := range pkg.Bindings {
+    var b Binding
+    if err := readJSON(filepath.Join(dir, name + ".json"), &b); err != nil {
+      return nil, nil, err
+    }
+    if b.Name != name {
+      return nil, nil, fmt.Errorf("%s.json: binding is named %q", name,
+          b.Name)
+    }
+    if b.Program == "" {
+      return nil, nil, fmt.Errorf("%s.json: program is not set", name)
+    }
+    bindings = append(bindings, &b)
+  }
+  return &pkg, bindings, nil
+}
+
+func readJSON(path string, v interface{}) error {
+  data, err := ioutil.ReadFile(path)
+  if err != nil {
+    return err
+  }
+  if err := json.Unmarshal(data, v); err != nil {
+    return fmt.Errorf("%s: %v", path, err)
+  }
+  return nil
+}
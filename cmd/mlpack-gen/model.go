@@ -0,0 +1,241 @@
+package main
+
+import (
+  "fmt"
+  "sort"
+  "strings"
+)
+
+// A model is a C++ model type together with the bindings that use it.
+type model struct {
+  name string
+  // The bindings that return the model and that take it as an input, by
+  // their Go names.
+  returnedBy, passedTo []string
+}
+
+// Collects the model types of the bindings, in the order in which they first
+// appear.  The bindings using each model are sorted by name.
+func collectModels(gens []*bindingGen) []*model {
+  var models []*model
+  byName := make(map[string]*model)
+  for _, g := range gens {
+    for _, p := range g.params {
+      if p.kind != kindModel {
+        continue
+      }
+      m, ok := byName[p.model]
+      if !ok {
+        m = &model{name: p.model}
+        byName[p.model] = m
+        models = append(models, m)
+      }
+      if p.Output {
+        m.returnedBy = appendUnique(m.returnedBy, g.funcName + "()")
+      } else {
+        m.passedTo = appendUnique(m.passedTo, g.funcName + "()")
+      }
+    }
+  }
+  for _, m := range models {
+    sort.Strings(m.returnedBy)
+    sort.Strings(m.passedTo)
+  }
+  return models
+}
+
+func appendUnique(list []string, s string) []string {
+  for _, t := range list {
+    if t == s {
+      return list
+    }
+  }
+  return append(list, s)
+}
+
+// Formats a list of names as "A", "A and B" or "A, B and C".
+func englishList(names []string) string {
+  if len(names) < 2 {
+    return strings.Join(names, "")
+  }
+  return strings.Join(names[:len(names)-1], ", ") + " and " +
+      names[len(names)-1]
+}
+
+// Generates models.go, which defines the Go type of each model.
+func modelsFile(models []*model) string {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString("package mlpack\n\n")
+  for _, m := range models {
+    t := goModelType(m.name)
+    doc := t + " holds a trained mlpack " + m.name + "."
+    if len(m.returnedBy) > 0 {
+      doc += "  It is returned by " + englishList(m.returnedBy)
+      if len(m.passedTo) > 0 {
+        doc += " and can be passed back to " + englishList(m.passedTo)
+      }
+      doc += "."
+    }
+    out.WriteString(lineComment("", doc))
+    r := strings.NewReplacer("$T", t, "$M", m.name)
+    out.WriteString(r.Replace(modelTemplate))
+  }
+  return out.String()
+}
+
+// The code for each model type; $T is replaced with the Go type and $M with
+// the C++ type.
+const modelTemplate = `type $T struct {
+  handle *modelHandle
+}
+
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *$T) Close() error {
+  m.handle.close()
+  return nil
+}
+
+`
+
+// Generates models_native.go, which passes the models to and from the mlpack
+// libraries.
+func nativeModelsFile(gens []*bindingGen, models []*model) string {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString(nativeConstraint + "package mlpack\n\n/*\n")
+  for _, g := range gens {
+    if len(g.models()) > 0 {
+      fmt.Fprintf(&out, "#include <capi/%s.h>\n", g.Name)
+    }
+  }
+  out.WriteString("*/\nimport \"C\"\n\nimport (\n  \"io\"\n  \"runtime\"\n" +
+      "  \"unsafe\"\n)\n\n")
+  for _, m := range models {
+    r := strings.NewReplacer("$T", goModelType(m.name), "$M", m.name)
+    out.WriteString(r.Replace(nativeModelTemplate))
+  }
+  return out.String()
+}
+
+const nativeModelTemplate = `func (m *$T) get$M(params *params, identifier string) {
+  m.handle = ownModel(params, C.mlpackGet$MPtr(params.mem,
+      cIdentifier(identifier)), free$M)
+}
+
+func set$M(params* params,
+                           identifier string,
+                           ptr *$T) {
+  if mem := useModel(params, identifier, ptr.handle); mem != nil {
+    C.mlpackSet$MPtr(params.mem, cIdentifier(identifier), mem)
+  }
+}
+
+func free$M(mem unsafe.Pointer) {
+  C.mlpackDelete$MPtr(mem)
+}
+
+// Save serializes the model to w in the given format.
+func (m *$T) Save(w io.Writer, format Format) error {
+  mem := m.handle.acquire()
+  defer m.handle.release()
+  if mem == nil {
+    return emptyModelError("$M")
+  }
+  var length C.size_t
+  buf := C.mlpackSerialize$MPtr(mem, C.int(format), &length)
+  runtime.KeepAlive(m)
+  return writeModel(w, "$M", unsafe.Pointer(buf), length)
+}
+
+// Load replaces the model with one deserialized from r.  The archive format is
+// detected from the data.  Copies of the model share its C++ object, so they
+// all see the loaded model; the old object is deleted once no binding call or
+// Save() uses it anymore.  If loading fails, the model is left unchanged.
+func (m *$T) Load(r io.Reader) error {
+  data, format, err := readModel(r, "$M")
+  if err != nil {
+    return err
+  }
+  ptr := C.mlpackDeserialize$MPtr(
+      (*C.char)(unsafe.Pointer(&data[0])), C.size_t(len(data)),
+      C.int(format))
+  if ptr == nil {
+    return loadError("$M", format)
+  }
+  m.handle = m.handle.replace(ptr, free$M)
+  return nil
+}
+
+`
+
+// Generates models_nomlpack.go, which replaces the model functions that need
+// the mlpack libraries in builds with the nomlpack tag.
+func stubModelsFile(models []*model) string {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString(stubConstraint + "package mlpack\n\nimport \"io\"\n\n")
+  for _, m := range models {
+    r := strings.NewReplacer("$T", goModelType(m.name))
+    out.WriteString(r.Replace(stubModelTemplate))
+  }
+  return out.String()
+}
+
+const stubModelTemplate = `// Save returns ErrNotAvailable.
+func (m *$T) Save(w io.Writer, format Format) error {
+  return ErrNotAvailable
+}
+
+// Load returns ErrNotAvailable.
+func (m *$T) Load(r io.Reader) error {
+  return ErrNotAvailable
+}
+
+`
+
+// Generates run_models.go, which lets Run() pass models of every type.
+func runModelsFile(models []*model) string {
+  var out strings.Builder
+  out.WriteString(generatedHeader)
+  out.WriteString(nativeConstraint + `package mlpack
+
+// A modelParam passes a model to mlpack and reads one back, for Run().
+type modelParam struct {
+  set func(p *params, identifier string, value interface{}) bool
+  get func(p *params, identifier string) interface{}
+}
+
+// The model types known to Run(), by the name of their C++ type without
+// namespaces, template arguments and pointer, e.g. "KNNModel" or "LARS".
+var modelParams = map[string]modelParam{
+`)
+  for _, m := range models {
+    r := strings.NewReplacer("$T", goModelType(m.name), "$M", m.name)
+    out.WriteString(r.Replace(runModelTemplate))
+  }
+  out.WriteString("}\n")
+  return out.String()
+}
+
+const runModelTemplate = `  "$M": {
+    set: func(p *params, identifier string, value interface{}) bool {
+      switch m := value.(type) {
+      case *$T:
+        set$M(p, identifier, m)
+      case $T:
+        set$M(p, identifier, &m)
+      default:
+        return false
+      }
+      return true
+    },
+    get: func(p *params, identifier string) interface{} {
+      var m $T
+      m.get$M(p, identifier)
+      return m
+    },
+  },
+`
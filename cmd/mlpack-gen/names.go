@@ -0,0 +1,88 @@
+package main
+
+import (
+  "go/token"
+  "strings"
+)
+
+// Converts an mlpack name such as "leaf_size" to "LeafSize".
+func camelCase(name string) string {
+  parts := strings.Split(name, "_")
+  for i, part := range parts {
+    if part != "" {
+      parts[i] = strings.ToUpper(part[:1]) + part[1:]
+    }
+  }
+  return strings.Join(parts, "")
+}
+
+// Converts an mlpack name such as "leaf_size" to "leafSize".
+func lowerCamelCase(name string) string {
+  s := camelCase(name)
+  if s == "" {
+    return s
+  }
+  return strings.ToLower(s[:1]) + s[1:]
+}
+
+// Returns a Go identifier for a function parameter or local variable.
+func localName(name string) string {
+  s := lowerCamelCase(name)
+  if token.Lookup(s).IsKeyword() {
+    return s + "_"
+  }
+  return s
+}
+
+// Wraps str so that no line is longer than 80 characters, starting each new
+// line with prefix.  Line breaks in str are kept, and the first line is not
+// prefixed.  This is the algorithm mlpack uses for its documentation, so
+// texts wrap the same way as in the other bindings.
+func hyphenate(str, prefix string) string {
+  margin := 80 - len(prefix)
+  if len(str) < margin {
+    return str
+  }
+  var out strings.Builder
+  pos := 0
+  for pos < len(str) {
+    split := -1
+    if i := strings.IndexByte(str[pos:], '\n'); i >= 0 && i <= margin {
+      split = pos + i
+    } else if len(str) - pos < margin {
+      split = len(str)
+    } else {
+      end := pos + margin + 1
+      if end > len(str) {
+        end = len(str)
+      }
+      split = strings.LastIndexByte(str[:end], ' ')
+      if split <= pos {
+        split = pos + margin
+      }
+    }
+    out.WriteString(str[pos:split])
+    if split < len(str) {
+      out.WriteByte('\n')
+      out.WriteString(prefix)
+    }
+    pos = split
+    if pos < len(str) && (str[pos] == ' ' || str[pos] == '\n') {
+      pos++
+    }
+  }
+  return out.String()
+}
+
+// Formats text as a // comment wrapped at 80 columns, with the given
+// indentation.
+func lineComment(indent, text string) string {
+  prefix := indent + "// "
+  lines := strings.Split(prefix + hyphenate(text, prefix), "\n")
+  for i, line := range lines {
+    // Breaks between two spaces leave one of them on either line.
+    line = strings.TrimLeft(strings.TrimPrefix(line, prefix), " ")
+    lines[i] = prefix + strings.TrimRight(line, " ")
+  }
+  return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+  "fmt"
+  "strings"
+)
+
+// The ensmallen optimizers that the programs of bindings with progress or
+// abort support may use.  Their names are redefined to a GoOptimizer that
+// adds the callback of go_params.hpp to every optimization; perStep is true
+// for optimizers that report every step instead of every epoch.
+var goOptimizers = []struct {
+  name string
+  perStep bool
+}{
+  {"L_BFGS", true},
+  {"StandardSGD", false},
+  {"MiniBatchSGD", false},
+  {"AMSGrad", false},
+  {"BBS_BB", false},
+  {"Adam", false},
+}
+
+// Generates the C++ source of the binding's library, which implements the
+// functions declared in its header on top of the mlpack program.
+func (g *bindingGen) source() string {
+  var out strings.Builder
+  fmt.Fprintf(&out, "/**\n * @file capi/%s.cpp\n *\n" +
+      " * This is an autogenerated file containing the implementation of the" +
+      "\n * functions declared in %s.h for the %s binding.\n */\n", g.Name,
+      g.Name, g.Name)
+  fmt.Fprintf(&out, "#include \"go_params.hpp\"\n#include \"%s.h\"\n\n",
+      g.Name)
+
+  if g.Progress || g.Abortable {
+    out.WriteString("// Report progress and check for aborts from the " +
+        "optimizers of the program.\nnamespace ens {\n")
+    for _, o := range goOptimizers {
+      fmt.Fprintf(&out, "typedef mlpack::go::GoOptimizer<%s, %t> Go%s;\n",
+          o.name, o.perStep, o.name)
+    }
+    out.WriteString("} // namespace ens\n\n")
+    for _, o := range goOptimizers {
+      fmt.Fprintf(&out, "#define %s Go%s\n", o.name, o.name)
+    }
+    out.WriteString("\n")
+  }
+  fmt.Fprintf(&out, "#define BINDING_TYPE BINDING_TYPE_GO\n#include <%s>\n\n",
+      g.Program)
+  out.WriteString("using namespace mlpack;\nusing namespace mlpack::go;\n\n" +
+      "extern \"C\" {\n\n")
+
+  fmt.Fprintf(&out, "void mlpack%s(void* params, void* timers)\n{\n" +
+      "  RunBinding(params, timers, mlpack_%s);\n", g.funcName, g.Name)
+  var cleanup []string
+  for _, p := range g.outputs {
+    if p.kind != kindModel {
+      continue
+    }
+    var inputs []string
+    for _, q := range g.params {
+      if q.kind == kindModel && !q.Output && q.model == p.model {
+        inputs = append(inputs, fmt.Sprintf("%q", q.Name))
+      }
+    }
+    cleanup = append(cleanup, fmt.Sprintf(
+        "    DeleteOutputModel<%s>(Get(params), %q,\n        { %s });\n",
+        strings.TrimSuffix(p.Type, "*"), p.Name, strings.Join(inputs, ", ")))
+  }
+  if len(cleanup) > 0 {
+    out.WriteString("  if (Get(params).hasError)\n  {\n" +
+        strings.Join(cleanup, "") + "  }\n")
+  }
+  out.WriteString("}\n\n")
+
+  for _, p := range g.models() {
+    m, t := p.model, strings.TrimSuffix(p.Type, "*")
+    fmt.Fprintf(&out, "void mlpackSet%sPtr(void* params,\n" +
+        "%sconst char* identifier,\n%svoid* value)\n{\n" +
+        "  Get(params).params.Get<%s*>(identifier) = (%s*) value;\n}\n\n", m,
+        strings.Repeat(" ", 18 + len(m)), strings.Repeat(" ", 18 + len(m)),
+        t, t)
+    fmt.Fprintf(&out, "void* mlpackGet%sPtr(void* params, " +
+        "const char* identifier)\n{\n" +
+        "  return Get(params).params.Get<%s*>(identifier);\n}\n\n", m, t)
+    fmt.Fprintf(&out, "char* mlpackSerialize%sPtr(void* ptr, int format, " +
+        "size_t* length)\n{\n" +
+        "  return SerializeModel((%s*) ptr, format, length);\n}\n\n", m, t)
+    fmt.Fprintf(&out, "void* mlpackDeserialize%sPtr(const char* buffer,\n" +
+        "%ssize_t length,\n%sint format)\n{\n" +
+        "  return DeserializeModel<%s>(buffer, length, format);\n}\n\n", m,
+        strings.Repeat(" ", 27 + len(m)), strings.Repeat(" ", 27 + len(m)), t)
+    fmt.Fprintf(&out, "void mlpackDelete%sPtr(void* ptr)\n{\n" +
+        "  delete (%s*) ptr;\n}\n\n", m, t)
+  }
+
+  out.WriteString("} // extern \"C\"\n")
+  return out.String()
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+  "fmt"
+  "strings"
+)
+
+// The kinds of parameters, by the C++ types mlpack uses for them.
+type kind int
+
+const (
+  kindInt kind = iota
+  kindDouble
+  kindBool
+  kindString
+  kindVecInt
+  kindVecString
+  kindMat
+  kindUmat
+  kindRow
+  kindUrow
+  kindCol
+  kindUcol
+  kindMatWithInfo
+  kindModel
+)
+
+// Armadillo types, with the suffix of the arma_util.go functions that convert
+// them.
+var armaKinds = map[string]struct {
+  kind kind
+  suffix string
+}{
+  "arma::mat": {kindMat, "Mat"},
+  "arma::Mat<size_t>": {kindUmat, "Umat"},
+  "arma::rowvec": {kindRow, "Row"},
+  "arma::Row<size_t>": {kindUrow, "Urow"},
+  "arma::vec": {kindCol, "Col"},
+  "arma::Col<size_t>": {kindUcol, "Ucol"},
+  "std::tuple<mlpack::data::DatasetInfo, arma::mat>":
+      {kindMatWithInfo, "MatWithInfo"},
+}
+
+// A param is a Param together with the names and types used for it in Go.
+type param struct {
+  Param
+  kind kind
+  // Suffix of the arma_util.go conversion functions, for matrices.
+  armaSuffix string
+  // Name of the C++ model type, e.g. "KNNModel" or "LARS", for models.
+  model string
+  // The C++ model type as written in comments, e.g. "LARS<>".
+  cppModel string
+}
+
+func newParam(p Param) (*param, error) {
+  q := &param{Param: p}
+  switch p.Type {
+  case "int":
+    q.kind = kindInt
+  case "double":
+    q.kind = kindDouble
+  case "bool":
+    q.kind = kindBool
+  case "std::string":
+    q.kind = kindString
+  case "std::vector<int>":
+    q.kind = kindVecInt
+  case "std::vector<std::string>":
+    q.kind = kindVecString
+  default:
+    if a, ok := armaKinds[p.Type]; ok {
+      q.kind = a.kind
+      q.armaSuffix = a.suffix
+    } else if strings.HasSuffix(p.Type, "*") {
+      q.kind = kindModel
+      q.cppModel = strings.TrimSuffix(p.Type, "*")
+      if i := strings.LastIndex(q.cppModel, "::"); i >= 0 {
+        q.cppModel = q.cppModel[i+2:]
+      }
+      q.model = q.cppModel
+      if i := strings.Index(q.model, "<"); i >= 0 {
+        q.model = q.model[:i]
+      }
+    } else {
+      return nil, fmt.Errorf("parameter %q has unsupported type %q", p.Name,
+          p.Type)
+    }
+  }
+  if p.Enum != "" && q.kind != kindString {
+    return nil, fmt.Errorf("parameter %q of type %q cannot be an enum", p.Name,
+        p.Type)
+  }
+  return q, nil
+}
+
+// Returns the Go type of the model type with the given C++ name.
+func goModelType(model string) string {
+  if strings.HasSuffix(model, "Model") {
+    return model
+  }
+  return model + "Model"
+}
+
+// Returns whether the parameter is a matrix, with or without dataset info.
+func (p *param) isMatrix() bool {
+  return p.armaSuffix != ""
+}
+
+// Returns whether the parameter is a vector.
+func (p *param) isVector() bool {
+  return p.kind == kindVecInt || p.kind == kindVecString
+}
+
+// Returns whether Go code passes the parameter when it is not nil, rather than
+// when it differs from its default.
+func (p *param) isPointer() bool {
+  return p.isMatrix() || p.kind == kindModel
+}
+
+// Returns the name of the field in the options struct, e.g. "LeafSize".
+func (p *param) field() string {
+  return camelCase(p.Name)
+}
+
+// Returns the Go type of an input.
+func (p *param) inputType() string {
+  switch p.kind {
+  case kindInt:
+    return "int"
+  case kindDouble:
+    return "float64"
+  case kindBool:
+    return "bool"
+  case kindString:
+    if p.Enum != "" {
+      return p.Enum
+    }
+    return "string"
+  case kindVecInt:
+    return "[]int"
+  case kindVecString:
+    return "[]string"
+  case kindMatWithInfo:
+    return "*matrixWithInfo"
+  case kindModel:
+    return "*" + goModelType(p.model)
+  }
+  return "mat.Matrix"
+}
+
+// Returns the Go type of an output.
+func (p *param) outputType() string {
+  switch p.kind {
+  case kindModel:
+    return goModelType(p.model)
+  case kindString:
+    return "string"
+  }
+  if p.isMatrix() {
+    return "*mat.Dense"
+  }
+  return p.inputType()
+}
+
+// Returns the type shown for the parameter in the documentation.
+func (p *param) docType() string {
+  if p.Output {
+    return strings.TrimPrefix(p.outputType(), "*")
+  }
+  return strings.TrimPrefix(p.inputType(), "*")
+}
+
+// Returns the zero value of the output type.
+func (p *param) zero() string {
+  switch p.kind {
+  case kindInt, kindDouble:
+    return "0"
+  case kindBool:
+    return "false"
+  case kindString:
+    return `""`
+  case kindModel:
+    return goModelType(p.model) + "{}"
+  }
+  return "nil"
+}
+
+// Returns the default value of an option as a Go expression.
+func (p *param) defaultValue() string {
+  if p.isPointer() || p.isVector() {
+    return "nil"
+  }
+  if p.kind == kindBool {
+    return "false"
+  }
+  def := ""
+  if p.Default != nil {
+    def = *p.Default
+  }
+  if p.kind == kindString {
+    return fmt.Sprintf("%q", def)
+  }
+  if def == "" {
+    return "0"
+  }
+  return def
+}
+
+// Returns the default value as shown in the documentation, or "" if it is
+// not shown.
+func (p *param) docDefault() string {
+  if p.Required || p.Default == nil || p.isPointer() || p.kind == kindBool {
+    return ""
+  }
+  if p.kind == kindString {
+    return "'" + *p.Default + "'"
+  }
+  return *p.Default
+}
+
+// Returns the statement that passes expr as the value of the parameter.
+func (p *param) setCall(expr string) string {
+  id := fmt.Sprintf("%q", p.Name)
+  switch p.kind {
+  case kindInt:
+    return "setParamInt(params, " + id + ", " + expr + ")"
+  case kindDouble:
+    return "setParamDouble(params, " + id + ", " + expr + ")"
+  case kindBool:
+    return "setParamBool(params, " + id + ", " + expr + ")"
+  case kindString:
+    if p.Enum != "" {
+      expr = "string(" + expr + ")"
+    }
+    return "setParamString(params, " + id + ", " + expr + ")"
+  case kindVecInt:
+    return "setParamVecInt(params, " + id + ", " + expr + ")"
+  case kindVecString:
+    return "setParamVecString(params, " + id + ", " + expr + ")"
+  case kindMat:
+    return fmt.Sprintf("gonumToArmaMat(params, %s, %s, %v)", id, expr,
+        p.NoTranspose)
+  case kindModel:
+    return "set" + p.model + "(params, " + id + ", " + expr + ")"
+  }
+  return "gonumToArma" + p.armaSuffix + "(params, " + id + ", " + expr + ")"
+}
+
+// Returns the statements that read an output into the variable v.
+func (p *param) getCall(v string) string {
+  id := fmt.Sprintf("%q", p.Name)
+  switch p.kind {
+  case kindInt:
+    return "  " + v + " := getParamInt(params, " + id + ")\n"
+  case kindDouble:
+    return "  " + v + " := getParamDouble(params, " + id + ")\n"
+  case kindBool:
+    return "  " + v + " := getParamBool(params, " + id + ")\n"
+  case kindString:
+    return "  " + v + " := getParamString(params, " + id + ")\n"
+  case kindVecInt:
+    return "  " + v + " := getParamVecInt(params, " + id + ")\n"
+  case kindVecString:
+    return "  " + v + " := getParamVecString(params, " + id + ")\n"
+  case kindModel:
+    return "  var " + v + " " + goModelType(p.model) + "\n" +
+        "  " + v + ".get" + p.model + "(params, " + id + ")\n"
+  }
+  return "  var " + v + "Ptr mlpackArma\n" +
+      "  " + v + " := " + v + "Ptr.armaToGonum" + p.armaSuffix + "(params, " +
+      id + ")\n"
+}
@@ -0,0 +1,46 @@
+// +build !nomlpack
+
+package mlpack
+
+/*
+#cgo CFLAGS: -I. -I/capi
+#include <capi/io_util.h>
+*/
+import "C"
+
+import "context"
+
+// Asks the binding call made with the given Params object to abort once ctx
+// is done.  The returned function must be called after the call has returned;
+// it waits until no more abort requests can be made, and reports whether one
+// was made.
+func watchContext(ctx context.Context, p *params) func() bool {
+  if ctx.Done() == nil {
+    return func() bool { return false }
+  }
+
+  done := make(chan struct{})
+  aborted := make(chan bool, 1)
+  go func() {
+    select {
+    case <-ctx.Done():
+      C.mlpackRequestAbort(p.mem)
+      aborted <- true
+    case <-done:
+      aborted <- false
+    }
+  }()
+  return func() bool {
+    close(done)
+    return <-aborted
+  }
+}
+
+// Returns the error to report for a binding call that failed with err: the
+// context's error if the call was aborted because of it, err otherwise.
+func contextError(ctx context.Context, aborted bool, err error) error {
+  if err != nil && aborted {
+    return ctx.Err()
+  }
+  return err
+}
@@ -0,0 +1,110 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "context"
+  "math/rand"
+  "runtime"
+  "testing"
+  "time"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Returns alternating labels 0 and 1 for n points.
+func alternatingLabels(n int) *mat.Dense {
+  labels := mat.NewDense(n, 1, nil)
+  for i := 0; i < n; i++ {
+    labels.Set(i, 0, float64(i % 2))
+  }
+  return labels
+}
+
+func TestContextAlreadyCancelled(t *testing.T) {
+  ctx, cancel := context.WithCancel(context.Background())
+  cancel()
+  rng := rand.New(rand.NewSource(6))
+  x := randomMatrix(rng, 40, 3)
+  labels := alternatingLabels(40)
+
+  calls := map[string]func() error{
+    "cf": func() error {
+      p := CfOptions()
+      p.Training = mat.NewDense(3, 3, []float64{0, 0, 1, 0, 1, 2, 1, 0, 3})
+      _, _, err := CfContext(ctx, p)
+      return err
+    },
+    "gmm_train": func() error {
+      _, err := GmmTrainContext(ctx, 2, x, GmmTrainOptions())
+      return err
+    },
+    "lmnn": func() error {
+      p := LmnnOptions()
+      p.Labels = labels
+      _, _, _, err := LmnnContext(ctx, x, p)
+      return err
+    },
+    "nca": func() error {
+      p := NcaOptions()
+      p.Labels = labels
+      _, err := NcaContext(ctx, x, p)
+      return err
+    },
+    "random_forest": func() error {
+      p := RandomForestOptions()
+      p.Training = x
+      p.Labels = labels
+      _, _, _, err := RandomForestContext(ctx, p)
+      return err
+    },
+    "sparse_coding": func() error {
+      p := SparseCodingOptions()
+      p.Training = x
+      p.Atoms = 2
+      _, _, _, err := SparseCodingContext(ctx, p)
+      return err
+    },
+  }
+  for name, call := range calls {
+    if err := call(); err != context.Canceled {
+      t.Errorf("%s with a cancelled context returned %v, want %v", name, err,
+          context.Canceled)
+    }
+  }
+}
+
+func TestContextDeadlineDuringLmnn(t *testing.T) {
+  if testing.Short() {
+    t.Skip("skipping the long Lmnn run in short mode")
+  }
+  rng := rand.New(rand.NewSource(7))
+  x := randomMatrix(rng, 3000, 20)
+  p := LmnnOptions()
+  p.Labels = alternatingLabels(3000)
+  p.K = 5
+  p.MaxIterations = 1000000
+  p.Tolerance = 0
+
+  runtime.GC()
+  before := heapBytes(t)
+  ctx, cancel := context.WithTimeout(context.Background(),
+      100 * time.Millisecond)
+  defer cancel()
+  start := time.Now()
+  _, _, _, err := LmnnContext(ctx, x, p)
+  elapsed := time.Since(start)
+  if err != context.DeadlineExceeded {
+    t.Fatalf("LmnnContext() past its deadline returned %v, want %v", err,
+        context.DeadlineExceeded)
+  }
+  if elapsed > 10 * time.Second {
+    t.Errorf("LmnnContext() took %v to stop after its deadline", elapsed)
+  }
+
+  // The Params object holds a copy of the 480 kB input; it must be freed.
+  runtime.GC()
+  if growth := heapBytes(t) - before; growth > 16<<10 {
+    t.Errorf("the C heap grew by %d bytes over an aborted Lmnn call", growth)
+  }
+}
@@ -0,0 +1,321 @@
+package mlpack
+
+import (
+  "encoding/json"
+  "fmt"
+  "io"
+  "math"
+  "strconv"
+  "strings"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A Tuple containing `float64` data (data) along with a boolean array
+// (Categoricals) indicating which dimensions are categorical (represented by
+// `true`) and which are numeric (represented by `false`).  The number of
+// elements in the boolean array should be the same as the dimensionality of
+// the data matrix.  It is expected that each row of the matrix corresponds to a
+// single data point when calling mlpack bindings.
+type matrixWithInfo struct {
+  Categoricals []bool
+  Data mat.Matrix
+}
+
+// A function used for initializing matrixWithInfo Tuple.  DatasetInfo.Encode()
+// builds one from string records instead.
+func DataAndInfo() *matrixWithInfo {
+  return &matrixWithInfo {
+    Categoricals: nil,
+    Data: nil,
+  }
+}
+
+// Datatype is the type of a dimension of a dataset.
+type Datatype int
+
+const (
+  // DatatypeNumeric dimensions hold numbers.
+  DatatypeNumeric Datatype = iota
+  // DatatypeCategorical dimensions hold categories, each encoded as an index.
+  DatatypeCategorical
+)
+
+// String returns the name of the type.
+func (t Datatype) String() string {
+  switch t {
+  case DatatypeNumeric:
+    return "numeric"
+  case DatatypeCategorical:
+    return "categorical"
+  }
+  return fmt.Sprintf("Datatype(%d)", int(t))
+}
+
+// UnseenCategory selects what DatasetInfo.Encode() does with a category that
+// has no mapping yet.
+type UnseenCategory int
+
+const (
+  // UnseenCategoryError makes Encode() fail.
+  UnseenCategoryError UnseenCategory = iota
+  // UnseenCategoryAdd maps the category to the next free index, as mlpack
+  // does when it loads a dataset.
+  UnseenCategoryAdd
+  // UnseenCategoryMissing encodes the category as NaN.
+  UnseenCategoryMissing
+)
+
+// DatasetInfo records the type of each dimension of a dataset and, for
+// categorical dimensions, which index stands for which category string.  It
+// mirrors mlpack's data::DatasetInfo.  Saving it along with a trained model
+// keeps the encoding of the data used for predictions consistent with the
+// training data, and allows predictions to be decoded.
+//
+// A DatasetInfo is not safe for concurrent use if Encode() or MapString() may
+// add mappings.
+type DatasetInfo struct {
+  // Unseen selects how Encode() handles categories without a mapping.
+  Unseen UnseenCategory
+
+  types []Datatype
+  categories [][]string
+  indexes []map[string]int
+}
+
+// NewDatasetInfo returns a DatasetInfo for the given number of dimensions, all
+// of them numeric.
+func NewDatasetInfo(dimensionality int) *DatasetInfo {
+  return &DatasetInfo{
+    types: make([]Datatype, dimensionality),
+    categories: make([][]string, dimensionality),
+    indexes: make([]map[string]int, dimensionality),
+  }
+}
+
+// Dimensionality returns the number of dimensions.
+func (d *DatasetInfo) Dimensionality() int {
+  return len(d.types)
+}
+
+// Type returns the type of the given dimension.
+func (d *DatasetInfo) Type(dim int) Datatype {
+  return d.types[dim]
+}
+
+// SetType sets the type of the given dimension.  Making a dimension numeric
+// drops its mappings.
+func (d *DatasetInfo) SetType(dim int, t Datatype) {
+  d.types[dim] = t
+  if t == DatatypeNumeric {
+    d.categories[dim] = nil
+    d.indexes[dim] = nil
+  }
+}
+
+// NumMappings returns the number of categories of the given dimension.
+func (d *DatasetInfo) NumMappings(dim int) int {
+  return len(d.categories[dim])
+}
+
+// Categories returns the categories of the given dimension; category i is
+// encoded as i.
+func (d *DatasetInfo) Categories(dim int) []string {
+  return append([]string(nil), d.categories[dim]...)
+}
+
+// MapString returns the index of a category of the given dimension, mapping it
+// to the next free index if it has no mapping yet.  The dimension becomes
+// categorical.
+func (d *DatasetInfo) MapString(s string, dim int) float64 {
+  d.types[dim] = DatatypeCategorical
+  if index, ok := d.indexes[dim][s]; ok {
+    return float64(index)
+  }
+  if d.indexes[dim] == nil {
+    d.indexes[dim] = make(map[string]int)
+  }
+  index := len(d.categories[dim])
+  d.categories[dim] = append(d.categories[dim], s)
+  d.indexes[dim][s] = index
+  return float64(index)
+}
+
+// UnmapString returns the category that the given value of a categorical
+// dimension stands for, for instance to decode predicted labels.
+func (d *DatasetInfo) UnmapString(value float64, dim int) (string, error) {
+  index := int(value)
+  if float64(index) != value || index < 0 ||
+     index >= len(d.categories[dim]) {
+    return "", fmt.Errorf("mlpack: %v is not a category of dimension %d",
+        value, dim)
+  }
+  return d.categories[dim][index], nil
+}
+
+// Fit makes each dimension that has a cell in records that is neither a
+// number nor a missing value ("" or "NA") categorical, and maps the categories
+// of all categorical dimensions in the order they first appear.  Existing
+// mappings are kept, so Fit may be called again with more data.
+func (d *DatasetInfo) Fit(records [][]string) error {
+  if err := d.checkRecords(records); err != nil {
+    return err
+  }
+  for dim := range d.types {
+    if d.types[dim] == DatatypeCategorical {
+      continue
+    }
+    for _, record := range records {
+      cell := strings.TrimSpace(record[dim])
+      if isMissingCell(cell) {
+        continue
+      }
+      if _, err := strconv.ParseFloat(cell, 64); err != nil {
+        d.types[dim] = DatatypeCategorical
+        break
+      }
+    }
+  }
+  for _, record := range records {
+    for dim, cell := range record {
+      cell = strings.TrimSpace(cell)
+      if d.types[dim] == DatatypeCategorical && !isMissingCell(cell) {
+        d.MapString(cell, dim)
+      }
+    }
+  }
+  return nil
+}
+
+// Encode converts records, each a data point, into a matrix with one row per
+// record, along with the mask of categorical dimensions, ready to be passed to
+// bindings such as DecisionTree().  Categories are encoded with their mapping;
+// categories without one are handled as selected by Unseen.  Missing values
+// ("" or "NA") are encoded as NaN.
+func (d *DatasetInfo) Encode(records [][]string) (*matrixWithInfo, error) {
+  if err := d.checkRecords(records); err != nil {
+    return nil, err
+  }
+  if len(records) == 0 {
+    return nil, fmt.Errorf("mlpack: no records to encode")
+  }
+  data := make([]float64, 0, len(records)*len(d.types))
+  for i, record := range records {
+    for dim, cell := range record {
+      cell = strings.TrimSpace(cell)
+      v, err := d.encodeCell(cell, dim)
+      if err != nil {
+        return nil, fmt.Errorf("mlpack: record %d, dimension %d: %v", i, dim,
+            err)
+      }
+      data = append(data, v)
+    }
+  }
+
+  categoricals := make([]bool, len(d.types))
+  for dim, t := range d.types {
+    categoricals[dim] = t == DatatypeCategorical
+  }
+  return &matrixWithInfo{
+    Categoricals: categoricals,
+    Data: mat.NewDense(len(records), len(d.types), data),
+  }, nil
+}
+
+// Encodes a single cell of the given dimension.
+func (d *DatasetInfo) encodeCell(cell string, dim int) (float64, error) {
+  if isMissingCell(cell) {
+    return math.NaN(), nil
+  }
+  if d.types[dim] == DatatypeNumeric {
+    v, err := strconv.ParseFloat(cell, 64)
+    if err != nil {
+      return 0, fmt.Errorf("%q is not a number", cell)
+    }
+    return v, nil
+  }
+  if index, ok := d.indexes[dim][cell]; ok {
+    return float64(index), nil
+  }
+  switch d.Unseen {
+  case UnseenCategoryAdd:
+    return d.MapString(cell, dim), nil
+  case UnseenCategoryMissing:
+    return math.NaN(), nil
+  }
+  return 0, fmt.Errorf("unseen category %q", cell)
+}
+
+// Checks that every record has one cell for each dimension.
+func (d *DatasetInfo) checkRecords(records [][]string) error {
+  for i, record := range records {
+    if len(record) != len(d.types) {
+      return fmt.Errorf("mlpack: record %d has %d cells, but the dataset " +
+          "has %d dimensions", i, len(record), len(d.types))
+    }
+  }
+  return nil
+}
+
+// Reports whether a cell holds a missing value.
+func isMissingCell(cell string) bool {
+  return cell == "" || cell == "NA"
+}
+
+// The JSON form of a DatasetInfo.
+type datasetInfoJSON struct {
+  Unseen UnseenCategory `json:"unseen"`
+  Dimensions []datasetDimensionJSON `json:"dimensions"`
+}
+
+type datasetDimensionJSON struct {
+  Type string `json:"type"`
+  Categories []string `json:"categories,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, so that a DatasetInfo can be stored
+// in the same JSON document as other settings.
+func (d *DatasetInfo) MarshalJSON() ([]byte, error) {
+  out := datasetInfoJSON{Unseen: d.Unseen,
+      Dimensions: make([]datasetDimensionJSON, len(d.types))}
+  for dim, t := range d.types {
+    out.Dimensions[dim] = datasetDimensionJSON{Type: t.String(),
+        Categories: d.categories[dim]}
+  }
+  return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DatasetInfo) UnmarshalJSON(data []byte) error {
+  var in datasetInfoJSON
+  if err := json.Unmarshal(data, &in); err != nil {
+    return err
+  }
+  info := NewDatasetInfo(len(in.Dimensions))
+  info.Unseen = in.Unseen
+  for dim, dimension := range in.Dimensions {
+    switch dimension.Type {
+    case "numeric":
+    case "categorical":
+      info.types[dim] = DatatypeCategorical
+      for _, s := range dimension.Categories {
+        info.MapString(s, dim)
+      }
+    default:
+      return fmt.Errorf("mlpack: unknown type %q of dimension %d",
+          dimension.Type, dim)
+    }
+  }
+  *d = *info
+  return nil
+}
+
+// Save writes the DatasetInfo to w as JSON.
+func (d *DatasetInfo) Save(w io.Writer) error {
+  return json.NewEncoder(w).Encode(d)
+}
+
+// Load replaces the DatasetInfo with one read from r, as written by Save().
+func (d *DatasetInfo) Load(r io.Reader) error {
+  return json.NewDecoder(r).Decode(d)
+}
@@ -0,0 +1,132 @@
+package mlpack
+
+import (
+  "bytes"
+  "encoding/json"
+  "math"
+  "reflect"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+var testRecords = [][]string{
+  {"red", "1.5", "small"},
+  {"green", "NA", "large"},
+  {"red", "-2", ""},
+}
+
+func TestDatasetInfoFit(t *testing.T) {
+  info := NewDatasetInfo(3)
+  if err := info.Fit(testRecords); err != nil {
+    t.Fatal(err)
+  }
+  wantTypes := []Datatype{DatatypeCategorical, DatatypeNumeric,
+      DatatypeCategorical}
+  for dim, want := range wantTypes {
+    if got := info.Type(dim); got != want {
+      t.Errorf("dimension %d is %v, want %v", dim, got, want)
+    }
+  }
+  if got := info.Categories(0); !reflect.DeepEqual(got,
+      []string{"red", "green"}) {
+    t.Errorf("categories = %q", got)
+  }
+  if info.NumMappings(1) != 0 || info.NumMappings(2) != 2 {
+    t.Errorf("mappings = %d and %d, want 0 and 2", info.NumMappings(1),
+        info.NumMappings(2))
+  }
+
+  data, err := info.Encode(testRecords)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if want := []bool{true, false, true}; !reflect.DeepEqual(data.Categoricals,
+      want) {
+    t.Errorf("categoricals = %v, want %v", data.Categoricals, want)
+  }
+  nan := math.NaN()
+  checkRows(t, data.Data.(*mat.Dense), [][]float64{
+    {0, 1.5, 0},
+    {1, nan, 1},
+    {0, -2, nan},
+  })
+
+  if s, err := info.UnmapString(1, 0); err != nil || s != "green" {
+    t.Errorf("UnmapString(1, 0) = %q, %v", s, err)
+  }
+  if _, err := info.UnmapString(2, 0); err == nil {
+    t.Error("UnmapString(2, 0) succeeded")
+  }
+}
+
+func TestDatasetInfoUnseen(t *testing.T) {
+  records := [][]string{{"blue"}, {"red"}}
+  tests := []struct {
+    unseen UnseenCategory
+    rows [][]float64
+    categories []string
+  }{
+    {UnseenCategoryError, nil, []string{"red"}},
+    {UnseenCategoryAdd, [][]float64{{1}, {0}}, []string{"red", "blue"}},
+    {UnseenCategoryMissing, [][]float64{{math.NaN()}, {0}}, []string{"red"}},
+  }
+  for _, test := range tests {
+    info := NewDatasetInfo(1)
+    info.MapString("red", 0)
+    info.Unseen = test.unseen
+    data, err := info.Encode(records)
+    if test.rows == nil {
+      if err == nil {
+        t.Errorf("policy %d: encoding an unseen category succeeded",
+            test.unseen)
+      }
+    } else if err != nil {
+      t.Errorf("policy %d: %v", test.unseen, err)
+    } else {
+      checkRows(t, data.Data.(*mat.Dense), test.rows)
+    }
+    if got := info.Categories(0); !reflect.DeepEqual(got, test.categories) {
+      t.Errorf("policy %d: categories = %q, want %q", test.unseen, got,
+          test.categories)
+    }
+  }
+}
+
+func TestDatasetInfoJSON(t *testing.T) {
+  info := NewDatasetInfo(3)
+  if err := info.Fit(testRecords); err != nil {
+    t.Fatal(err)
+  }
+  info.Unseen = UnseenCategoryMissing
+
+  var buf bytes.Buffer
+  if err := info.Save(&buf); err != nil {
+    t.Fatal(err)
+  }
+  loaded := NewDatasetInfo(0)
+  if err := loaded.Load(&buf); err != nil {
+    t.Fatal(err)
+  }
+  if !reflect.DeepEqual(loaded, info) {
+    t.Errorf("loaded %+v, want %+v", loaded, info)
+  }
+
+  // A DatasetInfo can be part of a larger document.
+  doc, err := json.Marshal(map[string]interface{}{"info": info})
+  if err != nil {
+    t.Fatal(err)
+  }
+  var decoded struct{ Info *DatasetInfo }
+  if err := json.Unmarshal(doc, &decoded); err != nil {
+    t.Fatal(err)
+  }
+  if !reflect.DeepEqual(decoded.Info, info) {
+    t.Errorf("decoded %+v, want %+v", decoded.Info, info)
+  }
+
+  bad := `{"dimensions": [{"type": "date"}]}`
+  if err := json.Unmarshal([]byte(bad), loaded); err == nil {
+    t.Error("unmarshaling an unknown type succeeded")
+  }
+}
@@ -6,5 +6,128 @@ learning algorithms. mlpack provides these algorithms as simple command-line
 programs, Go bindings, and C++ classes which can then be integrated into 
 larger-scale machine learning solutions.
 
+Every binding is available in three forms.  The plain form, such as Knn(),
+panics if mlpack reports an error.  The WithError form, such as
+KnnWithError(), returns the same outputs followed by an error, which is a
+*BindingError describing the failing binding, parameter and mlpack message.
+The WithTimers form, such as KnnWithTimers(), additionally returns the
+Timings that mlpack recorded during the call, such as the time spent building
+trees or computing neighbors.
+
+Bindings whose training can take a long time (Cf, GmmTrain, Lmnn, Nca,
+RandomForest and SparseCoding) also have a Context form, such as
+LmnnContext(), which stops the computation and returns ctx.Err() when the
+context is cancelled or its deadline passes.
+
+LinearSvm, LogisticRegression, Lmnn, Nca and SoftmaxRegression accept a
+Progress function, which is called after every iteration of the optimizer with
+the iteration number and the objective.  It can be used to record loss curves,
+and returning false stops training early with the model trained so far.  The
+function runs while the call holds the locks of the package and of its input
+model, so calling a binding, a model method or SetLogOutput() from it
+deadlocks.
+
+Each binding only passes an option to mlpack when it differs from its default
+value.  To pass an option explicitly even when it equals the default, for
+instance a Seed of 0, use the matching setter method:
+
+  param := mlpack.KnnOptions().SetK(5).SetSeed(0)
+
+Options that select among a fixed set of values have their own types with
+named constants, such as mlpack.TreeKD or mlpack.CFAlgorithmNMF.  Every options
+struct has a Validate() method that checks those values, numeric ranges and
+combinations of inputs (for instance that exactly one of Reference and
+InputModel is given to Knn()).  The WithError form calls Validate() before
+mlpack is called, so invalid options are reported as a *BindingError without
+doing any work.
+
+By default mlpack prints its log output, including the messages enabled by
+Verbose, directly to stdout and stderr.  SetLogOutput() routes it to any
+io.Writer instead, and the Log option of a binding does the same for a single
+call:
+
+  var buf bytes.Buffer
+  param := mlpack.PreprocessDescribeOptions()
+  param.Verbose = true
+  param.Log = &mlpack.LogOutput{Info: &buf}
+
+Bindings() lists the available bindings, and DescribeBinding() returns the
+parameters of one of them, with their types, defaults and documentation, as
+reported by the linked mlpack library.  CheckVersion() verifies that the linked
+libraries were built from the mlpack version this package was generated for.
+The libmlpack_go_* libraries are built from the C++ sources in capi/ and the
+mlpack sources with "make download build sudo_install"; see
+rel/deployment.md.
+
+Run() calls a binding chosen at runtime, with its parameters given as a map
+from mlpack names to values, and returns its outputs in the same way:
+
+  out, err := mlpack.Run("knn", map[string]interface{}{
+    "reference": [][]float64{{0, 1}, {1, 0}, {1, 1}},
+    "k": 1,
+  })
+  neighbors := out["neighbors"].(*mat.Dense)
+
+Building with the nomlpack tag leaves out the mlpack libraries and cgo, so
+that code using this package can be compiled and tested on machines without
+mlpack.  Every binding then returns ErrNotAvailable from its WithError,
+WithTimers and Context forms, and its plain form, such as Knn(), panics with
+ErrNotAvailable, like it panics with any other error.  Bindings call the
+current Backend, and SetBackend() replaces it, for instance with a fake that
+embeds UnavailableBackend and overrides the bindings a test calls:
+
+  type fakeKnn struct{ mlpack.UnavailableBackend }
+
+  func (fakeKnn) Knn(ctx context.Context, param *mlpack.KnnOptionalParam) (
+      *mat.Dense, *mat.Dense, mlpack.KNNModel, mlpack.Timings, error) {
+    ...
+  }
+
+  defer mlpack.SetBackend(mlpack.SetBackend(fakeKnn{}))
+
+Matrix inputs accept any gonum mat.Matrix, including views created with Slice,
+transposes, vectors and symmetric matrices; each row is a single point.  Empty
+inputs are rejected with a *BindingError.  A *mat.Dense whose rows are
+contiguous in memory and a *mat.VecDense with unit increment are passed to
+mlpack without copying, which matters for matrices of several gigabytes;
+other inputs are copied once.  There is no limit on the size of inputs or
+outputs besides memory.  Outputs are always new *mat.Dense
+values that own their memory.
+
+Trained models, such as the KNNModel returned by Knn(), own a C++ object.  Call
+Close() to delete it as soon as the model is no longer needed; models that are
+never closed are deleted by a finalizer once they become unreachable.  Copies of
+a model value share the same C++ object, and a model returned by a binding that
+was given the same model as its InputModel shares it too.
+
+All bindings and model methods are safe to call from multiple goroutines, and
+calls run in parallel with the following exceptions:
+
+  - A call that sets Verbose or Log changes mlpack's process-wide log streams,
+    so it holds a package-wide lock for its whole run: it waits for all
+    running calls to finish, and every other call, with or without these
+    options, blocks until it returns.  A long training run with Verbose set
+    thus stops all other calls of the program.  mlpack's log streams cannot
+    be told apart by call, so there is no cheaper way; leave Verbose and Log
+    unset in code that makes calls in parallel.  SetLogOutput() waits for
+    running calls in the same way, but afterwards calls run in parallel
+    again, with their warnings routed to its writers.
+  - Calls that are given the same model, and Save() or Close() on that model,
+    run one after the other, since a binding may modify the model it is
+    given.  To use a model in parallel, Save() it and Load() it into a new
+    model value for each goroutine.
+  - Options structs and input matrices must not be modified while a call that
+    uses them is running.
+
+Many bindings also use OpenMP threads internally.  SetNumThreads() limits the
+number of threads each call may use, which avoids oversubscribing the CPUs
+when calls are made from several goroutines; the Threads option does the same
+for a single call.
+
+A Seed makes results reproducible only when no other call runs at the same
+time, since mlpack's random number generators are shared by the process.
+
 */
 package mlpack // import "mlpack.org/v1/mlpack"
+
+//go:generate go run ./cmd/mlpack-gen
@@ -0,0 +1,30 @@
+package mlpack
+
+import "errors"
+
+// ErrNotAvailable is returned by every binding, and by the other functions that
+// need the mlpack libraries, when the package is built with the nomlpack tag.
+var ErrNotAvailable = errors.New("mlpack: built without the mlpack " +
+    "libraries (nomlpack tag)")
+
+// BindingError describes a failure reported by an mlpack binding.  It is
+// returned by the error-returning variants of every binding (for instance
+// KnnWithError()) when mlpack throws an exception during the call, or when an
+// input is rejected before the call is made.
+type BindingError struct {
+  // Binding is the name of the mlpack binding that failed, e.g. "knn".
+  Binding string
+  // Param is the name of the parameter the error refers to, if known.
+  Param string
+  // Message is the message of the exception thrown by mlpack.
+  Message string
+}
+
+// Error implements the error interface.
+func (e *BindingError) Error() string {
+  if e.Param != "" {
+    return "mlpack: " + e.Binding + ": parameter '" + e.Param + "': " +
+        e.Message
+  }
+  return "mlpack: " + e.Binding + ": " + e.Message
+}
@@ -0,0 +1,36 @@
+package mlpack
+
+import "sync"
+
+// Go values that C code refers to during a binding call, such as the writers
+// that receive mlpack's log output, are registered here and passed to C as an
+// integer handle, since cgo does not allow passing Go pointers that C keeps
+// after the call that received them returns.
+var handles = struct {
+  sync.RWMutex
+  next uintptr
+  values map[uintptr]interface{}
+}{values: make(map[uintptr]interface{})}
+
+// Registers the given value and returns its handle, which is never 0.
+func newHandle(value interface{}) uintptr {
+  handles.Lock()
+  defer handles.Unlock()
+  handles.next++
+  handles.values[handles.next] = value
+  return handles.next
+}
+
+// Returns the value registered with the given handle, or nil if there is none.
+func handleValue(h uintptr) interface{} {
+  handles.RLock()
+  defer handles.RUnlock()
+  return handles.values[h]
+}
+
+// Unregisters the value with the given handle.
+func deleteHandle(h uintptr) {
+  handles.Lock()
+  defer handles.Unlock()
+  delete(handles.values, h)
+}
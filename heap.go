@@ -0,0 +1,36 @@
+// +build !nomlpack
+
+package mlpack
+
+/*
+#include <stdlib.h>
+#ifdef __GLIBC__
+#include <malloc.h>
+#endif
+
+// Returns the bytes allocated with malloc() and not yet freed, or -1 if the C
+// library cannot tell.
+static long long mlpackGoHeapInUse()
+{
+#if defined(__GLIBC__)
+#if __GLIBC_PREREQ(2, 33)
+  struct mallinfo2 info = mallinfo2();
+  return (long long) (info.uordblks + info.hblkhd);
+#else
+  struct mallinfo info = mallinfo();
+  return (long long) (unsigned int) info.uordblks +
+      (long long) (unsigned int) info.hblkhd;
+#endif
+#else
+  return -1;
+#endif
+}
+*/
+import "C"
+
+// Returns the number of bytes allocated on the C heap, which holds the C++
+// objects of binding calls and models, or -1 if it is not known on this
+// platform.  The tests use it to find leaks on the C side.
+func cHeapBytes() int64 {
+  return int64(C.mlpackGoHeapInUse())
+}
@@ -0,0 +1,76 @@
+// +build !nomlpack
+
+package mlpack
+
+/*
+#cgo CFLAGS: -I. -I/capi
+#include <capi/io_util.h>
+*/
+import "C"
+
+import (
+  "fmt"
+  "sort"
+)
+
+// Bindings returns the names of all bindings in this package, e.g. "knn" for
+// Knn(), in sorted order.
+func Bindings() []string {
+  names := make([]string, 0, len(bindingRunners))
+  for name := range bindingRunners {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+  return names
+}
+
+// A paramInfo is a ParamSpec plus what Run() needs to know to pass the
+// parameter to mlpack.
+type paramInfo struct {
+  ParamSpec
+  noTranspose bool
+}
+
+// Reads the parameters of the binding that the given Params object was created
+// for, sorted by name.
+func readParams(p *params) []paramInfo {
+  n := C.mlpackNumParams(p.mem)
+  infos := make([]paramInfo, 0, int(n))
+  for i := C.size_t(0); i < n; i++ {
+    infos = append(infos, paramInfo{
+      ParamSpec: ParamSpec{
+        Name: C.GoString(C.mlpackParamName(p.mem, i)),
+        Type: C.GoString(C.mlpackParamType(p.mem, i)),
+        Description: C.GoString(C.mlpackParamDescription(p.mem, i)),
+        Default: C.GoString(C.mlpackParamDefault(p.mem, i)),
+        Input: bool(C.mlpackParamIsInput(p.mem, i)),
+        Required: bool(C.mlpackParamIsRequired(p.mem, i)),
+      },
+      noTranspose: bool(C.mlpackParamNoTranspose(p.mem, i)),
+    })
+  }
+  sort.Slice(infos, func(i, j int) bool {
+    return infos[i].Name < infos[j].Name
+  })
+  return infos
+}
+
+// DescribeBinding returns the description and parameters of the binding with
+// the given name, as reported by the linked mlpack library.
+func DescribeBinding(name string) (BindingSpec, error) {
+  if _, ok := bindingRunners[name]; !ok {
+    return BindingSpec{}, fmt.Errorf("mlpack: unknown binding %q", name)
+  }
+
+  params := getParams(name)
+  defer cleanParams(params)
+
+  spec := BindingSpec{
+    Name: name,
+    Description: C.GoString(C.mlpackBindingDescription(params.mem)),
+  }
+  for _, info := range readParams(params) {
+    spec.Params = append(spec.Params, info.ParamSpec)
+  }
+  return spec, nil
+}
@@ -0,0 +1,73 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "encoding/json"
+  "io/ioutil"
+  "path/filepath"
+  "reflect"
+  "sort"
+  "testing"
+)
+
+// Bindings() must list exactly the bindings generated from rel/metadata.
+func TestBindings(t *testing.T) {
+  data, err := ioutil.ReadFile(filepath.Join("rel", "metadata",
+      "package.json"))
+  if err != nil {
+    t.Fatal(err)
+  }
+  var pkg struct {
+    Bindings []string `json:"bindings"`
+  }
+  if err := json.Unmarshal(data, &pkg); err != nil {
+    t.Fatal(err)
+  }
+  want := append([]string{}, pkg.Bindings...)
+  sort.Strings(want)
+  if got := Bindings(); !reflect.DeepEqual(got, want) {
+    t.Errorf("Bindings() = %v, want %v", got, want)
+  }
+}
+
+func TestDescribeBinding(t *testing.T) {
+  spec, err := DescribeBinding("knn")
+  if err != nil {
+    t.Fatalf("DescribeBinding(\"knn\") failed: %v", err)
+  }
+  if spec.Name != "knn" || spec.Description == "" {
+    t.Errorf("DescribeBinding(\"knn\") = %q, %q", spec.Name, spec.Description)
+  }
+
+  var leafSize *ParamSpec
+  for i, p := range spec.Params {
+    if i > 0 && spec.Params[i-1].Name >= p.Name {
+      t.Errorf("parameters are not sorted at %q", p.Name)
+    }
+    if p.Name == "leaf_size" {
+      leafSize = &spec.Params[i]
+    }
+  }
+  if leafSize == nil {
+    t.Fatal("DescribeBinding(\"knn\") does not list leaf_size")
+  }
+  want := ParamSpec{Name: "leaf_size", Type: "int", Default: "20",
+      Input: true, Description: leafSize.Description}
+  if *leafSize != want || leafSize.Description == "" {
+    t.Errorf("leaf_size is %+v, want %+v", *leafSize, want)
+  }
+
+  if _, err := DescribeBinding("no_such_binding"); err == nil {
+    t.Error("DescribeBinding() of an unknown binding succeeded")
+  }
+}
+
+func TestCheckVersion(t *testing.T) {
+  if err := CheckVersion(); err != nil {
+    t.Errorf("CheckVersion() failed: %v", err)
+  }
+  if v := Version(); v != BindingsVersion {
+    t.Errorf("Version() = %q, want %q", v, BindingsVersion)
+  }
+}
@@ -1,28 +1,59 @@
+// +build !nomlpack
+
 package mlpack
 
 /*
 #cgo CFLAGS: -I. -I/capi
 #cgo LDFLAGS: -L${SRCDIR} -Wl,-rpath,${SRCDIR} -lmlpack_go_util
+#include <stdlib.h>
 #include <capi/io_util.h>
 */
 import "C"
 
 import (
+  "fmt"
+  "io"
+  "reflect"
   "runtime"
+  "sync"
+  "time"
   "unsafe"
 )
 
-type params struct {
+type timers struct {
   mem unsafe.Pointer
 }
 
-type timers struct {
-  mem unsafe.Pointer
+// Binding names and parameter identifiers come from a fixed set, so each one is
+// converted to a C string once and kept for the lifetime of the process
+// instead of being allocated on every parameter access.
+var cIdentifiers = struct {
+  sync.RWMutex
+  m map[string]*C.char
+}{m: make(map[string]*C.char)}
+
+// Returns the interned C string for the given identifier.
+func cIdentifier(identifier string) *C.char {
+  cIdentifiers.RLock()
+  c, ok := cIdentifiers.m[identifier]
+  cIdentifiers.RUnlock()
+  if ok {
+    return c
+  }
+
+  cIdentifiers.Lock()
+  defer cIdentifiers.Unlock()
+  if c, ok := cIdentifiers.m[identifier]; ok {
+    return c
+  }
+  c = C.CString(identifier)
+  cIdentifiers.m[identifier] = c
+  return c
 }
 
 func getParams(binding string) *params {
-  ptr := C.mlpackGetParams(C.CString(binding))
-  p := &params { mem: ptr }
+  ptr := C.mlpackGetParams(cIdentifier(binding))
+  p := &params { mem: ptr, binding: binding }
   runtime.KeepAlive(p)
   return p
 }
@@ -36,41 +67,65 @@ func getTimers() *timers {
 
 func cleanParams(p *params) {
   C.mlpackCleanParams(p.mem)
+  releaseModels(p)
+  for _, h := range p.handles {
+    deleteHandle(h)
+  }
 }
 
 func cleanTimers(t *timers) {
   C.mlpackCleanTimers(t.mem)
 }
 
+// Returns the error recorded for the binding call made with the given Params
+// object, or nil if the call succeeded.
+func getError(p *params) error {
+  if p.err != nil {
+    return p.err
+  }
+  msg := C.mlpackGetErrorMessage(p.mem)
+  if msg == nil {
+    return nil
+  }
+  err := &BindingError{Binding: p.binding, Message: C.GoString(msg)}
+  if param := C.mlpackGetErrorParam(p.mem); param != nil {
+    err.Param = C.GoString(param)
+  }
+  return err
+}
+
 func hasParam(p *params, identifier string) bool {
-  return bool((C.mlpackHasParam(p.mem, C.CString(identifier))))
+  return bool((C.mlpackHasParam(p.mem, cIdentifier(identifier))))
 }
 
 func setPassed(p *params, identifier string) {
-  C.mlpackSetPassed(p.mem, C.CString(identifier))
+  C.mlpackSetPassed(p.mem, cIdentifier(identifier))
 }
 
 func setParamDouble(p *params, identifier string, value float64) {
-  C.mlpackSetParamDouble(p.mem, C.CString(identifier), C.double(value))
+  C.mlpackSetParamDouble(p.mem, cIdentifier(identifier), C.double(value))
 }
 
 func setParamInt(p *params, identifier string, value int) {
-  C.mlpackSetParamInt(p.mem, C.CString(identifier), C.int(value))
+  C.mlpackSetParamInt(p.mem, cIdentifier(identifier), C.int(value))
 }
 func setParamFloat(p *params, identifier string, value float64) {
-  C.mlpackSetParamFloat(p.mem, C.CString(identifier), C.float(value))
+  C.mlpackSetParamFloat(p.mem, cIdentifier(identifier), C.float(value))
 }
 
 func setParamBool(p *params, identifier string, value bool) {
-  C.mlpackSetParamBool(p.mem, C.CString(identifier), C.bool(value))
+  C.mlpackSetParamBool(p.mem, cIdentifier(identifier), C.bool(value))
 }
 
 func setParamString(p *params, identifier string, value string) {
-  C.mlpackSetParamString(p.mem, C.CString(identifier), C.CString(value))
+  // mlpack copies the value, so it can be freed right away.
+  cValue := C.CString(value)
+  defer C.free(unsafe.Pointer(cValue))
+  C.mlpackSetParamString(p.mem, cIdentifier(identifier), cValue)
 }
 
 func setParamPtr(p *params, identifier string, ptr unsafe.Pointer) {
-  C.mlpackSetParamPtr(p.mem, C.CString(identifier), (*C.double)(ptr))
+  C.mlpackSetParamPtr(p.mem, cIdentifier(identifier), (*C.double)(ptr))
 }
 
 func enableTimers() {
@@ -90,22 +145,27 @@ func enableVerbose() {
 }
 
 func getParamString(p *params, identifier string) string {
-  val := C.GoString(C.mlpackGetParamString(p.mem, C.CString(identifier)))
+  val := C.GoString(C.mlpackGetParamString(p.mem, cIdentifier(identifier)))
   return val
 }
 
 func getParamBool(p *params, identifier string) bool {
-  val := bool(C.mlpackGetParamBool(p.mem, C.CString(identifier)))
+  val := bool(C.mlpackGetParamBool(p.mem, cIdentifier(identifier)))
   return val
 }
 
 func getParamInt(p *params, identifier string) int {
-  val := int(C.mlpackGetParamInt(p.mem, C.CString(identifier)))
+  val := int(C.mlpackGetParamInt(p.mem, cIdentifier(identifier)))
   return val
 }
 
 func getParamDouble(p *params, identifier string) float64 {
-  val := float64(C.mlpackGetParamDouble(p.mem, C.CString(identifier)))
+  val := float64(C.mlpackGetParamDouble(p.mem, cIdentifier(identifier)))
+  return val
+}
+
+func getParamFloat(p *params, identifier string) float64 {
+  val := float64(C.mlpackGetParamFloat(p.mem, cIdentifier(identifier)))
   return val
 }
 
@@ -114,7 +174,7 @@ type mlpackVectorType struct {
 }
 
 func (v *mlpackVectorType) allocVecIntPtr(p *params, identifier string) {
-  v.mem = C.mlpackGetVecIntPtr(p.mem, C.CString(identifier))
+  v.mem = C.mlpackGetVecIntPtr(p.mem, cIdentifier(identifier))
   runtime.KeepAlive(v)
 }
 
@@ -124,43 +184,164 @@ func setParamVecInt(p *params, identifier string, vecInt []int) {
   for i := 0; i < len(vecInt); i++ {
     vecInt64[i] = int64(vecInt[i])
   }
-  ptr := unsafe.Pointer(&vecInt64[0])
+  var ptr unsafe.Pointer
+  if len(vecInt64) > 0 {
+    ptr = unsafe.Pointer(&vecInt64[0])
+  }
   // As we are not guaranteed  that int is always equivalent of int64_t or
   // int32_t in Go. Hence we are passing `long long` to C++.
-  C.mlpackSetParamVectorInt(p.mem, C.CString(identifier),
+  C.mlpackSetParamVectorInt(p.mem, cIdentifier(identifier),
       (*C.longlong)(ptr), C.size_t(len(vecInt)))
 }
 
 func setParamVecString(p *params, identifier string, vecString []string) {
-  C.mlpackSetParamVectorStrLen(p.mem, C.CString(identifier),
+  C.mlpackSetParamVectorStrLen(p.mem, cIdentifier(identifier),
       C.size_t(len(vecString)))
   for i := 0; i < len(vecString); i++ {
-    C.mlpackSetParamVectorStr(p.mem, C.CString(identifier),
-        (C.CString)(vecString[i]), C.size_t(i))
+    cValue := C.CString(vecString[i])
+    C.mlpackSetParamVectorStr(p.mem, cIdentifier(identifier), cValue,
+        C.size_t(i))
+    C.free(unsafe.Pointer(cValue))
   }
 }
 
 func getParamVecInt(p *params, identifier string) []int {
-  e := int(C.mlpackVecIntSize(p.mem, C.CString(identifier)))
+  e := int(C.mlpackVecIntSize(p.mem, cIdentifier(identifier)))
 
   var v mlpackVectorType
   v.allocVecIntPtr(p, identifier)
 
-  data := (*[1<<30 - 1]int)(v.mem)
-  output := data[:e]
-  if output != nil {
-    return output
+  // Copy the data into Go memory, since the vector is freed along with the
+  // Params object.
+  output := make([]int, e)
+  if v.mem != nil && e > 0 {
+    copy(output, cInts(v.mem, e))
   }
-  return []int{}
+  return output
+}
+
+// Returns a slice of the n float64 values of C memory at ptr.  Unlike a cast to
+// a pointer to a fixed-size array, this works for any n.  The slice aliases the
+// C memory, so it must not be used after the memory is freed.
+func cFloat64s(ptr unsafe.Pointer, n int) []float64 {
+  var s []float64
+  h := (*reflect.SliceHeader)(unsafe.Pointer(&s))
+  h.Data = uintptr(ptr)
+  h.Len = n
+  h.Cap = n
+  return s
+}
+
+// Like cFloat64s(), but for int values.
+func cInts(ptr unsafe.Pointer, n int) []int {
+  var s []int
+  h := (*reflect.SliceHeader)(unsafe.Pointer(&s))
+  h.Data = uintptr(ptr)
+  h.Len = n
+  h.Cap = n
+  return s
 }
 
 func getParamVecString(p *params, identifier string) []string {
-  e := int(C.mlpackVecStringSize(p.mem, C.CString(identifier)))
+  e := int(C.mlpackVecStringSize(p.mem, cIdentifier(identifier)))
 
   data := make([]string, e)
   for i := 0; i < e; i++ {
     data[i] = C.GoString(C.mlpackGetVecStringPtr(p.mem,
-        C.CString(identifier), C.size_t(i)))
+        cIdentifier(identifier), C.size_t(i)))
   }
   return data
 }
+
+// Returns the time recorded by each timer of the given Timers object.
+func getTimings(t *timers) Timings {
+  n := C.mlpackNumTimers(t.mem)
+  timings := make(Timings, int(n))
+  for i := C.size_t(0); i < n; i++ {
+    name := C.GoString(C.mlpackTimerName(t.mem, i))
+    timings[name] = time.Duration(C.mlpackTimerMicroseconds(t.mem, i)) *
+        time.Microsecond
+  }
+  return timings
+}
+
+// Writes a model serialized by the C side to w.  The buffer was allocated with
+// malloc() and is freed here.
+func writeModel(w io.Writer, typeName string, buf unsafe.Pointer,
+                length C.size_t) error {
+  if buf == nil {
+    return fmt.Errorf("mlpack: could not serialize %s", typeName)
+  }
+  defer C.free(buf)
+
+  _, err := w.Write(C.GoBytes(buf, C.int(length)))
+  return err
+}
+
+// Registers a model passed as an input to a binding call and returns its C++
+// object.  The handle stays reachable and acquired until the Params object is
+// cleaned, so the model cannot be finalized or used by another call meanwhile.
+// A closed or zero-value model records an error for the parameter instead, and
+// nil is returned.
+func useModel(p *params, identifier string, h *modelHandle) unsafe.Pointer {
+  mem := h.acquire()
+  if mem == nil {
+    h.release()
+    setError(p, identifier, "model is closed or empty")
+    return nil
+  }
+  if p.models == nil {
+    p.models = make(map[unsafe.Pointer]*modelHandle)
+  }
+  p.models[mem] = h
+  return mem
+}
+
+// Releases the models registered with useModel().
+func releaseModels(p *params) {
+  for _, h := range p.models {
+    h.release()
+  }
+  p.models = nil
+}
+
+// Takes ownership of a model returned by a binding call.  Bindings may return
+// the very object they were given as an input model (for instance when a
+// model is only used for prediction); in that case the input's handle is
+// shared instead of creating a second owner for the same object.
+func ownModel(p *params, mem unsafe.Pointer,
+              free func(unsafe.Pointer)) *modelHandle {
+  if h, ok := p.models[mem]; ok {
+    return h
+  }
+  return newModelHandle(mem, free)
+}
+
+// Sets the number of threads for the binding call made with the given Params
+// object, as chosen by callThreads().
+func setNumThreads(p *params, threads int) {
+  threads, err := callThreads(p.binding, threads)
+  if err != nil {
+    setError(p, err.Param, err.Message)
+    return
+  }
+  if threads > 0 {
+    C.mlpackSetNumThreads(p.mem, C.int(threads))
+  }
+}
+
+// Returns the number of threads that OpenMP uses by default.
+func defaultNumThreads() int {
+  return int(C.mlpackGetDefaultNumThreads())
+}
+
+// Returns the number of threads that OpenMP may use on the calling thread,
+// which inside a binding call is the limit set for that call.
+func currentNumThreads() int {
+  return int(C.mlpackGetCurrentNumThreads())
+}
+
+// Returns the version of the linked mlpack library.
+func libraryVersion() string {
+  return C.GoString(C.mlpackVersion())
+}
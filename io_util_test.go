@@ -0,0 +1,37 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "testing"
+  "unsafe"
+)
+
+// cFloat64s() and cInts() must alias the given memory, whatever its length.
+func TestCSlices(t *testing.T) {
+  for _, n := range []int{1, 7, 1 << 16} {
+    floats := make([]float64, n)
+    s := cFloat64s(unsafe.Pointer(&floats[0]), n)
+    if len(s) != n || cap(s) != n || &s[0] != &floats[0] {
+      t.Errorf("cFloat64s() of %d values has length %d and capacity %d, " +
+          "or does not alias the memory", n, len(s), cap(s))
+      continue
+    }
+    s[n-1] = 1.5
+    if floats[n-1] != 1.5 {
+      t.Errorf("cFloat64s() of %d values does not alias the last value", n)
+    }
+
+    ints := make([]int, n)
+    u := cInts(unsafe.Pointer(&ints[0]), n)
+    if len(u) != n || cap(u) != n || &u[0] != &ints[0] {
+      t.Errorf("cInts() of %d values has length %d and capacity %d, " +
+          "or does not alias the memory", n, len(u), cap(u))
+      continue
+    }
+    u[n-1] = 3
+    if ints[n-1] != 3 {
+      t.Errorf("cInts() of %d values does not alias the last value", n)
+    }
+  }
+}
@@ -0,0 +1,161 @@
+package mlpack
+
+import (
+  "bufio"
+  "fmt"
+  "io"
+  "strconv"
+  "strings"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// LibSVMOptions configures LoadLibSVM().  The zero value reads 1-based
+// indexes and takes the dimensionality from the largest index.
+type LibSVMOptions struct {
+  // ZeroBased is true if feature indexes start at 0 instead of 1.
+  ZeroBased bool
+  // Dimensionality is the number of features.  Features that no line gives
+  // are 0.  If Dimensionality is 0, it is the largest index found.
+  Dimensionality int
+}
+
+// LibSVMError reports a line of a LibSVM file that LoadLibSVM() cannot read.
+type LibSVMError struct {
+  // Line is the number of the line, starting at 1.
+  Line int
+  // Message describes the problem.
+  Message string
+}
+
+// Error implements the error interface.
+func (e *LibSVMError) Error() string {
+  return fmt.Sprintf("mlpack: LibSVM line %d: %s", e.Line, e.Message)
+}
+
+// A feature value read from a LibSVM file.
+type libSVMValue struct {
+  row, col int
+  value float64
+}
+
+// LoadLibSVM reads a file in the sparse LibSVM or SVMlight format, where each
+// line is a label followed by index:value pairs, such as "1 3:0.5 10:2".  It
+// returns the features, with one row per line, and the labels, as a matrix
+// with a single column, ready to be passed to bindings such as LinearSvm().
+// Comments starting with '#' and "qid:" pairs are ignored.  Input compressed
+// with gzip is detected and decompressed.
+func LoadLibSVM(r io.Reader, opts LibSVMOptions) (*mat.Dense, *mat.Dense,
+                                                  error) {
+  in, err := gunzipIfNeeded(r)
+  if err != nil {
+    return nil, nil, err
+  }
+  scanner := bufio.NewScanner(in)
+  scanner.Buffer(nil, 1<<30)
+  base := 1
+  if opts.ZeroBased {
+    base = 0
+  }
+
+  var labels []float64
+  var values []libSVMValue
+  cols := opts.Dimensionality
+  for line := 1; scanner.Scan(); line++ {
+    text := scanner.Text()
+    if i := strings.IndexByte(text, '#'); i >= 0 {
+      text = text[:i]
+    }
+    fields := strings.Fields(text)
+    if len(fields) == 0 {
+      continue
+    }
+    fail := func(format string, args ...interface{}) error {
+      return &LibSVMError{Line: line, Message: fmt.Sprintf(format, args...)}
+    }
+
+    label, err := strconv.ParseFloat(fields[0], 64)
+    if err != nil {
+      return nil, nil, fail("label %q is not a number", fields[0])
+    }
+    row := len(labels)
+    labels = append(labels, label)
+    for _, field := range fields[1:] {
+      i := strings.IndexByte(field, ':')
+      if i < 0 {
+        return nil, nil, fail("%q is not an index:value pair", field)
+      }
+      if field[:i] == "qid" {
+        continue
+      }
+      index, err := strconv.Atoi(field[:i])
+      if err != nil || index < base {
+        return nil, nil, fail("invalid feature index %q", field[:i])
+      }
+      col := index - base
+      if opts.Dimensionality > 0 && col >= opts.Dimensionality {
+        return nil, nil, fail("feature index %d is beyond the " +
+            "dimensionality %d", index, opts.Dimensionality)
+      }
+      value, err := strconv.ParseFloat(field[i+1:], 64)
+      if err != nil {
+        return nil, nil, fail("value %q is not a number", field[i+1:])
+      }
+      if col >= cols {
+        cols = col + 1
+      }
+      values = append(values, libSVMValue{row, col, value})
+    }
+  }
+  if err := scanner.Err(); err != nil {
+    return nil, nil, err
+  }
+  if len(labels) == 0 || cols == 0 {
+    return nil, nil, fmt.Errorf("mlpack: LibSVM has no data")
+  }
+
+  x := mat.NewDense(len(labels), cols, nil)
+  for _, v := range values {
+    x.Set(v.row, v.col, v.value)
+  }
+  return x, mat.NewDense(len(labels), 1, labels), nil
+}
+
+// SaveLibSVM writes the rows of x, with the labels in y, to w in the LibSVM
+// format, with 1-based indexes.  y must be a row or column vector with one
+// element for each row of x.  Only the features that are not 0 are written.
+func SaveLibSVM(w io.Writer, x, y mat.Matrix) error {
+  r, c := x.Dims()
+  yr, yc := y.Dims()
+  if (yr != 1 && yc != 1) || yr*yc != r {
+    return fmt.Errorf("mlpack: labels must be a vector with one element " +
+        "for each of the %d rows", r)
+  }
+
+  out := bufio.NewWriter(w)
+  var line []byte
+  for i := 0; i < r; i++ {
+    var label float64
+    if yc == 1 {
+      label = y.At(i, 0)
+    } else {
+      label = y.At(0, i)
+    }
+    line = strconv.AppendFloat(line[:0], label, 'g', -1, 64)
+    for j := 0; j < c; j++ {
+      v := x.At(i, j)
+      if v == 0 {
+        continue
+      }
+      line = append(line, ' ')
+      line = strconv.AppendInt(line, int64(j+1), 10)
+      line = append(line, ':')
+      line = strconv.AppendFloat(line, v, 'g', -1, 64)
+    }
+    line = append(line, '\n')
+    if _, err := out.Write(line); err != nil {
+      return err
+    }
+  }
+  return out.Flush()
+}
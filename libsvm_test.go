@@ -0,0 +1,113 @@
+package mlpack
+
+import (
+  "bytes"
+  "strings"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+func TestLoadLibSVM(t *testing.T) {
+  tests := []struct {
+    name string
+    in string
+    opts LibSVMOptions
+    x [][]float64
+    y []float64
+  }{
+    {"one-based", "1 1:0.5 3:2\n-1 qid:4 2:1 # comment\n", LibSVMOptions{},
+        [][]float64{{0.5, 0, 2}, {0, 1, 0}}, []float64{1, -1}},
+    {"zero-based", "1 0:0.5 2:2\n\n0 1:1\n",
+        LibSVMOptions{ZeroBased: true},
+        [][]float64{{0.5, 0, 2}, {0, 1, 0}}, []float64{1, 0}},
+    {"dimensionality", "2 1:1\n3 2:4\n", LibSVMOptions{Dimensionality: 4},
+        [][]float64{{1, 0, 0, 0}, {0, 4, 0, 0}}, []float64{2, 3}},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      x, y, err := LoadLibSVM(strings.NewReader(test.in), test.opts)
+      if err != nil {
+        t.Fatal(err)
+      }
+      checkRows(t, x, test.x)
+      if r, c := y.Dims(); r != len(test.y) || c != 1 {
+        t.Fatalf("labels are %dx%d, want %dx1", r, c, len(test.y))
+      }
+      for i, want := range test.y {
+        if got := y.At(i, 0); got != want {
+          t.Errorf("label %d = %v, want %v", i, got, want)
+        }
+      }
+    })
+  }
+}
+
+func TestLoadLibSVMErrors(t *testing.T) {
+  tests := []struct {
+    name string
+    in string
+    opts LibSVMOptions
+    line int
+  }{
+    {"index 0 when one-based", "1 1:1\n1 0:1\n", LibSVMOptions{}, 2},
+    {"beyond dimensionality", "1 3:1\n", LibSVMOptions{Dimensionality: 2}, 1},
+    {"beyond dimensionality zero-based", "1 2:1\n",
+        LibSVMOptions{ZeroBased: true, Dimensionality: 2}, 1},
+    {"no pair", "1 1:1\n\n1 2\n", LibSVMOptions{}, 3},
+    {"bad label", "x 1:1\n", LibSVMOptions{}, 1},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      _, _, err := LoadLibSVM(strings.NewReader(test.in), test.opts)
+      svmErr, ok := err.(*LibSVMError)
+      if !ok {
+        t.Fatalf("got error %v, want a *LibSVMError", err)
+      }
+      if svmErr.Line != test.line {
+        t.Errorf("error on line %d, want %d", svmErr.Line, test.line)
+      }
+    })
+  }
+}
+
+func TestLibSVMRoundTrip(t *testing.T) {
+  // The last column is all 0, so it is not in the file.
+  x := mat.NewDense(3, 5, []float64{
+    0, 1.5, 0, -2, 0,
+    0, 0, 0, 0, 0,
+    3, 0, 0.25, 0, 0,
+  })
+  y := mat.NewVecDense(3, []float64{1, 0, 2})
+  var buf bytes.Buffer
+  if err := SaveLibSVM(&buf, x, y); err != nil {
+    t.Fatal(err)
+  }
+  want := "1 2:1.5 4:-2\n0\n2 1:3 3:0.25\n"
+  if buf.String() != want {
+    t.Errorf("saved %q, want %q", buf.String(), want)
+  }
+
+  loaded, _, err := LoadLibSVM(strings.NewReader(want), LibSVMOptions{})
+  if err != nil {
+    t.Fatal(err)
+  }
+  if _, c := loaded.Dims(); c != 4 {
+    t.Errorf("loaded %d columns without Dimensionality, want 4", c)
+  }
+  loaded, labels, err := LoadLibSVM(strings.NewReader(want),
+      LibSVMOptions{Dimensionality: 5})
+  if err != nil {
+    t.Fatal(err)
+  }
+  if !mat.Equal(loaded, x) {
+    t.Errorf("loaded %v, want %v", mat.Formatted(loaded), mat.Formatted(x))
+  }
+  if !mat.Equal(labels, y) {
+    t.Errorf("labels %v, want %v", mat.Formatted(labels), mat.Formatted(y))
+  }
+
+  if err := SaveLibSVM(&buf, x, mat.NewVecDense(2, nil)); err == nil {
+    t.Error("saving with too few labels succeeded")
+  }
+}
@@ -0,0 +1,113 @@
+// +build !nomlpack
+
+package mlpack
+
+/*
+#cgo CFLAGS: -I. -I/capi
+#include <capi/io_util.h>
+
+void mlpackGoLogWrite(uintptr_t handle, int stream, char* message,
+                      size_t length);
+*/
+import "C"
+
+import (
+  "io"
+  "os"
+  "sync"
+  "unsafe"
+)
+
+// The handle of the writers set with SetLogOutput(), or 0 if mlpack prints
+// directly to stdout and stderr.  It is only accessed while holding callLock
+// for writing.
+var defaultLogHandle uintptr
+
+// SetLogOutput routes the log output of every binding call to the given
+// writers instead of printing it to stdout and stderr.  This includes the
+// informational messages printed when Verbose is set and the tables printed
+// by bindings such as PreprocessDescribe().  Passing nil for both writers
+// restores printing directly to stdout and stderr.  The Log option of a
+// binding overrides this for a single call.
+//
+// SetLogOutput waits for running binding calls to finish.  The writers may be
+// called from threads created by mlpack, but never by two threads at once.
+func SetLogOutput(info, warn io.Writer) {
+  callLock.Lock()
+  defer callLock.Unlock()
+  // As in restoreLog(), the old handle is only deleted once mlpack no longer
+  // routes to it.
+  old := defaultLogHandle
+  defaultLogHandle = 0
+  if info != nil || warn != nil {
+    defaultLogHandle = newHandle(newLogWriter(&LogOutput{Info: info,
+        Warn: warn}))
+  }
+  routeLog(defaultLogHandle)
+  if old != 0 {
+    deleteHandle(old)
+  }
+}
+
+// The writers that receive mlpack's log output.
+type logWriter struct {
+  mu sync.Mutex
+  info io.Writer
+  warn io.Writer
+}
+
+func newLogWriter(out *LogOutput) *logWriter {
+  w := &logWriter{info: out.Info, warn: out.Warn}
+  if w.info == nil {
+    w.info = os.Stdout
+  }
+  if w.warn == nil {
+    w.warn = os.Stderr
+  }
+  return w
+}
+
+// Sends mlpack's log output to the writers with the given handle, or to
+// stdout and stderr if the handle is 0.
+func routeLog(handle uintptr) {
+  if handle == 0 {
+    C.mlpackSetLogCallback(nil, 0)
+  } else {
+    C.mlpackSetLogCallback(C.mlpackLogCallback(C.mlpackGoLogWrite),
+        C.uintptr_t(handle))
+  }
+}
+
+// Routes mlpack's log output to out for a single binding call, and returns
+// the handle to pass to restoreLog() once the call is done.  The caller must
+// hold callLock for writing.
+func redirectLog(out *LogOutput) uintptr {
+  handle := newHandle(newLogWriter(out))
+  routeLog(handle)
+  return handle
+}
+
+// Undoes redirectLog().
+func restoreLog(handle uintptr) {
+  routeLog(defaultLogHandle)
+  deleteHandle(handle)
+}
+
+//export mlpackGoLogWrite
+func mlpackGoLogWrite(handle C.uintptr_t, stream C.int, message *C.char,
+                      length C.size_t) {
+  w, ok := handleValue(uintptr(handle)).(*logWriter)
+  if !ok {
+    return
+  }
+  data := C.GoBytes(unsafe.Pointer(message), C.int(length))
+
+  w.mu.Lock()
+  defer w.mu.Unlock()
+  // Write errors cannot be reported back to mlpack, so they are dropped.
+  if stream == C.MLPACK_LOG_WARN {
+    w.warn.Write(data)
+  } else {
+    w.info.Write(data)
+  }
+}
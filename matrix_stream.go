@@ -0,0 +1,148 @@
+package mlpack
+
+import (
+  "bufio"
+  "compress/gzip"
+  "encoding/csv"
+  "io"
+  "strconv"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// The number of rows in each batch of a MatrixReader, unless BatchSize is set.
+const defaultBatchSize = 1024
+
+// A MatrixReader reads a numeric CSV file in batches of rows, so that files
+// larger than memory can be processed, for instance by passing each batch to
+// HoeffdingTree() or Perceptron() with the model trained so far.  Input
+// compressed with gzip is detected and decompressed.
+//
+// The embedded CSVOptions and BatchSize may be changed until the first call
+// of Next().  Cells are parsed as by LoadCSV().
+type MatrixReader struct {
+  CSVOptions
+  // BatchSize is the maximum number of rows of each batch.  0 means 1024.
+  BatchSize int
+
+  r io.Reader
+  parser *csvParser
+  err error
+}
+
+// NewMatrixReader returns a MatrixReader that reads from r.
+func NewMatrixReader(r io.Reader) *MatrixReader {
+  return &MatrixReader{r: r}
+}
+
+// Next returns the next batch of rows.  All batches have BatchSize rows except
+// the last one, which may have fewer.  Next returns io.EOF once all rows have
+// been read; errors are reported as by LoadCSV(), and returned again by every
+// later call.
+func (r *MatrixReader) Next() (*mat.Dense, error) {
+  if r.err != nil {
+    return nil, r.err
+  }
+  if r.parser == nil {
+    in, err := gunzipIfNeeded(r.r)
+    if err != nil {
+      r.err = err
+      return nil, err
+    }
+    r.parser = newCSVParser(in, r.CSVOptions)
+  }
+
+  size := r.BatchSize
+  if size <= 0 {
+    size = defaultBatchSize
+  }
+  var data []float64
+  rows := 0
+  for rows < size {
+    var err error
+    data, err = r.parser.next(data)
+    if err == io.EOF {
+      r.err = io.EOF
+      break
+    }
+    if err != nil {
+      r.err = err
+      return nil, err
+    }
+    rows++
+  }
+  if rows == 0 || r.parser.cols == 0 {
+    return nil, io.EOF
+  }
+  return mat.NewDense(rows, r.parser.cols, data), nil
+}
+
+// Names returns the column names read from the header, or nil if the file has
+// no header or Next() was not called yet.
+func (r *MatrixReader) Names() []string {
+  if r.parser == nil {
+    return nil
+  }
+  return r.parser.names
+}
+
+// A MatrixWriter appends the rows of matrices to a CSV file.  Its fields may be
+// changed between writes.  Output is buffered; call Flush() when done.
+type MatrixWriter struct {
+  // Comma is the field delimiter.  NewMatrixWriter() sets it to ','.
+  Comma rune
+  // Format and Precision are passed to strconv.FormatFloat() for each value.
+  // NewMatrixWriter() sets them to 'g' and -1, the shortest representation
+  // that reads back as the same value.
+  Format byte
+  Precision int
+
+  w *csv.Writer
+  record []string
+}
+
+// NewMatrixWriter returns a MatrixWriter that writes to w.
+func NewMatrixWriter(w io.Writer) *MatrixWriter {
+  return &MatrixWriter{Comma: ',', Format: 'g', Precision: -1,
+      w: csv.NewWriter(w)}
+}
+
+// WriteHeader writes a record with the given column names.
+func (w *MatrixWriter) WriteHeader(names []string) error {
+  w.w.Comma = w.Comma
+  return w.w.Write(names)
+}
+
+// Write appends each row of m as a record.
+func (w *MatrixWriter) Write(m mat.Matrix) error {
+  w.w.Comma = w.Comma
+  r, c := m.Dims()
+  for i := 0; i < r; i++ {
+    w.record = w.record[:0]
+    for j := 0; j < c; j++ {
+      w.record = append(w.record, strconv.FormatFloat(m.At(i, j), w.Format,
+          w.Precision, 64))
+    }
+    if err := w.w.Write(w.record); err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+func (w *MatrixWriter) Flush() error {
+  w.w.Flush()
+  return w.w.Error()
+}
+
+// Returns a reader of the decompressed data if r starts with the gzip magic
+// number, or a reader of the data as is otherwise.
+func gunzipIfNeeded(r io.Reader) (io.Reader, error) {
+  in := bufio.NewReader(r)
+  magic, err := in.Peek(2)
+  if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+    return gzip.NewReader(in)
+  }
+  return in, nil
+}
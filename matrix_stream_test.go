@@ -0,0 +1,249 @@
+package mlpack
+
+import (
+  "bytes"
+  "compress/gzip"
+  "io"
+  "math"
+  "reflect"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Writes a matrix with the given number of rows, where row i is (i, -i), and
+// returns it as CSV with a header.
+func numberedCSV(t *testing.T, rows int) []byte {
+  var buf bytes.Buffer
+  w := NewMatrixWriter(&buf)
+  if err := w.WriteHeader([]string{"i", "minus"}); err != nil {
+    t.Fatal(err)
+  }
+  if rows > 0 {
+    m := mat.NewDense(rows, 2, nil)
+    for i := 0; i < rows; i++ {
+      m.Set(i, 0, float64(i))
+      m.Set(i, 1, -float64(i))
+    }
+    if err := w.Write(m); err != nil {
+      t.Fatal(err)
+    }
+  }
+  if err := w.Flush(); err != nil {
+    t.Fatal(err)
+  }
+  return buf.Bytes()
+}
+
+// Reads all batches and checks their sizes and rows.
+func checkBatches(t *testing.T, r *MatrixReader, sizes []int) {
+  t.Helper()
+  row := 0
+  for i, size := range sizes {
+    batch, err := r.Next()
+    if err != nil {
+      t.Fatalf("batch %d: %v", i, err)
+    }
+    n, _ := batch.Dims()
+    if n != size {
+      t.Errorf("batch %d has %d rows, want %d", i, n, size)
+    }
+    for j := 0; j < n; j++ {
+      if batch.At(j, 0) != float64(row) || batch.At(j, 1) != -float64(row) {
+        t.Errorf("batch %d row %d is %v, want row %d", i, j,
+            mat.Row(nil, j, batch), row)
+      }
+      row++
+    }
+  }
+  for i := 0; i < 2; i++ {
+    if _, err := r.Next(); err != io.EOF {
+      t.Fatalf("Next() after the last batch returned %v, want io.EOF", err)
+    }
+  }
+}
+
+func TestMatrixReaderBatches(t *testing.T) {
+  tests := []struct {
+    rows, batchSize int
+    sizes []int
+  }{
+    {10, 3, []int{3, 3, 3, 1}},
+    {9, 3, []int{3, 3, 3}},
+    {3, 5, []int{3}},
+    {1, 1, []int{1}},
+    {0, 4, nil},
+    {2000, 0, []int{defaultBatchSize, 2000 - defaultBatchSize}},
+  }
+  for _, test := range tests {
+    r := NewMatrixReader(bytes.NewReader(numberedCSV(t, test.rows)))
+    r.BatchSize = test.batchSize
+    checkBatches(t, r, test.sizes)
+    if test.rows > 0 {
+      if names := r.Names(); !reflect.DeepEqual(names,
+          []string{"i", "minus"}) {
+        t.Errorf("Names() = %q", names)
+      }
+    }
+  }
+}
+
+func TestMatrixReaderGzip(t *testing.T) {
+  var buf bytes.Buffer
+  w := gzip.NewWriter(&buf)
+  w.Write(numberedCSV(t, 7))
+  w.Close()
+
+  r := NewMatrixReader(&buf)
+  r.BatchSize = 4
+  checkBatches(t, r, []int{4, 3})
+}
+
+func TestMatrixReaderError(t *testing.T) {
+  r := NewMatrixReader(bytes.NewReader([]byte("1,2\n3,4\n5,x\n7,8\n")))
+  r.BatchSize = 1
+  for i := 0; i < 2; i++ {
+    if _, err := r.Next(); err != nil {
+      t.Fatal(err)
+    }
+  }
+  // The error is returned again by later calls.
+  for i := 0; i < 2; i++ {
+    _, err := r.Next()
+    if csvErr, ok := err.(*CSVError); !ok || csvErr.Row != 3 {
+      t.Fatalf("got error %v, want a *CSVError for row 3", err)
+    }
+  }
+}
+
+// Returns whether a and b hold the same values, with NaN equal to NaN and 0
+// different from -0.
+func sameBits(a, b mat.Matrix) bool {
+  ar, ac := a.Dims()
+  br, bc := b.Dims()
+  if ar != br || ac != bc {
+    return false
+  }
+  for i := 0; i < ar; i++ {
+    for j := 0; j < ac; j++ {
+      if math.Float64bits(a.At(i, j)) != math.Float64bits(b.At(i, j)) {
+        return false
+      }
+    }
+  }
+  return true
+}
+
+// Values written with the default Format and Precision read back exactly.
+func TestMatrixWriterRoundTrip(t *testing.T) {
+  m := mat.NewDense(3, 3, []float64{
+    math.NaN(), 0.1, 1.0 / 3,
+    math.Inf(1), math.Inf(-1), math.Copysign(0, -1),
+    1e300, -4.9e-324, 42,
+  })
+  for _, header := range [][]string{nil, {"a", "b", "c"}} {
+    var buf bytes.Buffer
+    w := NewMatrixWriter(&buf)
+    if header != nil {
+      if err := w.WriteHeader(header); err != nil {
+        t.Fatal(err)
+      }
+    }
+    // Rows of several matrices are appended.
+    if err := w.Write(m.Slice(0, 1, 0, 3)); err != nil {
+      t.Fatal(err)
+    }
+    if err := w.Write(m.Slice(1, 3, 0, 3)); err != nil {
+      t.Fatal(err)
+    }
+    if err := w.Flush(); err != nil {
+      t.Fatal(err)
+    }
+
+    r := NewMatrixReader(&buf)
+    got, err := r.Next()
+    if err != nil {
+      t.Fatalf("header %q: reading back failed: %v", header, err)
+    }
+    if !sameBits(got, m) {
+      t.Errorf("header %q: read back %v, want %v", header, mat.Formatted(got),
+          mat.Formatted(m))
+    }
+    if names := r.Names(); !reflect.DeepEqual(names, header) {
+      t.Errorf("header %q: Names() = %q", header, names)
+    }
+  }
+}
+
+func TestMatrixWriterOutput(t *testing.T) {
+  m := mat.NewDense(2, 2, []float64{1, 2.5, 1.0 / 3, -1e-7})
+  tests := []struct {
+    name string
+    setup func(w *MatrixWriter)
+    want string
+  }{
+    {"default", func(w *MatrixWriter) {},
+        "x,y\n1,2.5\n0.3333333333333333,-1e-07\n"},
+    {"Comma", func(w *MatrixWriter) { w.Comma = ';' },
+        "x;y\n1;2.5\n0.3333333333333333;-1e-07\n"},
+    {"tab", func(w *MatrixWriter) { w.Comma = '\t' },
+        "x\ty\n1\t2.5\n0.3333333333333333\t-1e-07\n"},
+    {"Format f", func(w *MatrixWriter) { w.Format = 'f'; w.Precision = 2 },
+        "x,y\n1.00,2.50\n0.33,-0.00\n"},
+    {"Format e", func(w *MatrixWriter) { w.Format = 'e'; w.Precision = 3 },
+        "x,y\n1.000e+00,2.500e+00\n3.333e-01,-1.000e-07\n"},
+    {"Precision", func(w *MatrixWriter) { w.Precision = 3 },
+        "x,y\n1,2.5\n0.333,-1e-07\n"},
+  }
+  for _, test := range tests {
+    var buf bytes.Buffer
+    w := NewMatrixWriter(&buf)
+    test.setup(w)
+    if err := w.WriteHeader([]string{"x", "y"}); err != nil {
+      t.Fatal(err)
+    }
+    if err := w.Write(m); err != nil {
+      t.Fatal(err)
+    }
+    if err := w.Flush(); err != nil {
+      t.Fatal(err)
+    }
+    if got := buf.String(); got != test.want {
+      t.Errorf("%s: wrote %q, want %q", test.name, got, test.want)
+    }
+  }
+}
+
+// The fields of a MatrixWriter apply from the next write on.
+func TestMatrixWriterChangeFields(t *testing.T) {
+  var buf bytes.Buffer
+  w := NewMatrixWriter(&buf)
+  if err := w.Write(mat.NewDense(1, 2, []float64{0.5, 1})); err != nil {
+    t.Fatal(err)
+  }
+  w.Comma = ';'
+  w.Format = 'f'
+  w.Precision = 1
+  if err := w.Write(mat.NewDense(1, 2, []float64{0.25, 2})); err != nil {
+    t.Fatal(err)
+  }
+  if err := w.Flush(); err != nil {
+    t.Fatal(err)
+  }
+  if got, want := buf.String(), "0.5,1\n0.2;2.0\n"; got != want {
+    t.Errorf("wrote %q, want %q", got, want)
+  }
+
+  // A file written with another delimiter reads back with that delimiter.
+  buf.Reset()
+  w = NewMatrixWriter(&buf)
+  w.Comma = ';'
+  m := mat.NewDense(2, 2, []float64{1, math.NaN(), -2, 0.75})
+  w.Write(m)
+  w.Flush()
+  r := NewMatrixReader(&buf)
+  r.Comma = ';'
+  if got, err := r.Next(); err != nil || !sameBits(got, m) {
+    t.Errorf("reading back with ';' gave %v, %v", got, err)
+  }
+}
@@ -0,0 +1,179 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "io/ioutil"
+  "math/rand"
+  "os"
+  "runtime"
+  "strconv"
+  "strings"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Returns a rows x cols matrix of uniform random values.
+func randomMatrix(rng *rand.Rand, rows, cols int) *mat.Dense {
+  data := make([]float64, rows*cols)
+  for i := range data {
+    data[i] = rng.Float64()
+  }
+  return mat.NewDense(rows, cols, data)
+}
+
+// Returns the resident set size of the process in bytes, read from
+// /proc/self/statm, which also counts memory allocated by the C++ side.
+func residentBytes(t *testing.T) int64 {
+  t.Helper()
+  buf, err := ioutil.ReadFile("/proc/self/statm")
+  if err != nil {
+    t.Skipf("cannot read the resident set size: %v", err)
+  }
+  fields := strings.Fields(string(buf))
+  if len(fields) < 2 {
+    t.Fatalf("unexpected /proc/self/statm: %q", buf)
+  }
+  pages, err := strconv.ParseInt(fields[1], 10, 64)
+  if err != nil {
+    t.Fatal(err)
+  }
+  return pages * int64(os.Getpagesize())
+}
+
+// Makes n Knn calls that train and close a model.
+func knnCalls(t *testing.T, reference *mat.Dense, n int) {
+  for i := 0; i < n; i++ {
+    param := KnnOptions()
+    param.Reference = reference
+    param.K = 5
+    _, _, model, err := KnnWithError(param)
+    if err != nil {
+      t.Fatal(err)
+    }
+    model.Close()
+  }
+}
+
+// Returns the bytes in use on the C heap, skipping the test if the C library
+// cannot tell.
+func heapBytes(t *testing.T) int64 {
+  t.Helper()
+  n := cHeapBytes()
+  if n < 0 {
+    t.Skip("the C library does not report its heap usage")
+  }
+  return n
+}
+
+// Repeated binding calls must not grow the C heap: the Params and Timers
+// objects, the output matrices copied into Go and the closed models are all
+// freed.
+func TestBindingCallsDoNotGrowHeap(t *testing.T) {
+  if testing.Short() {
+    t.Skip("skipping the heap growth test in short mode")
+  }
+  reference := randomMatrix(rand.New(rand.NewSource(1)), 500, 5)
+
+  // Let allocators and caches reach their steady state first.
+  knnCalls(t, reference, 50)
+  runtime.GC()
+  before := heapBytes(t)
+
+  knnCalls(t, reference, 1000)
+  runtime.GC()
+  after := heapBytes(t)
+
+  // Leaking as little as one parameter string per call adds more than 16 kB
+  // over 1000 calls.
+  if growth := after - before; growth > 16<<10 {
+    t.Errorf("the C heap grew by %d bytes over 1000 Knn calls", growth)
+  }
+}
+
+// Setting string parameters must free the C strings made for the values, and
+// identifiers are only converted once.
+func TestParamStringsDoNotGrowHeap(t *testing.T) {
+  knn := getParams("knn")
+  defer cleanParams(knn)
+  converter := getParams("image_converter")
+  defer cleanParams(converter)
+  values := []string{"a-file-name-long-enough-to-need-the-heap.png", "b.png"}
+  set := func() {
+    setParamString(knn, "algorithm", "a-value-long-enough-to-need-the-heap")
+    setParamVecString(converter, "input", values)
+    cIdentifier("input")
+  }
+  for i := 0; i < 10; i++ {
+    set()
+  }
+  before := heapBytes(t)
+
+  for i := 0; i < 10000; i++ {
+    set()
+  }
+  if growth := heapBytes(t) - before; growth > 4<<10 {
+    t.Errorf("the C heap grew by %d bytes over 10000 string parameter " +
+        "settings", growth)
+  }
+}
+
+// The resident set size also sees memory that is not allocated with malloc(),
+// but it moves with the allocator's caches, so its bound is loose.
+func TestBindingCallsDoNotGrowResidentSet(t *testing.T) {
+  if testing.Short() {
+    t.Skip("skipping the resident set growth test in short mode")
+  }
+  reference := randomMatrix(rand.New(rand.NewSource(1)), 500, 5)
+  knnCalls(t, reference, 50)
+  runtime.GC()
+  before := residentBytes(t)
+
+  knnCalls(t, reference, 1000)
+  runtime.GC()
+  after := residentBytes(t)
+
+  // Each call allocates 2 * 500 * 5 * 8 bytes for the neighbors and distances
+  // alone, so leaking them would add 40 MB.
+  if growth := after - before; growth > 16<<20 {
+    t.Errorf("resident set grew by %d bytes over 1000 Knn calls", growth)
+  }
+}
+
+// Outputs are copied out of the C++ side, so neither the garbage collector nor
+// later calls, which reuse the freed C++ memory, may change them.
+func TestOutputsSurviveGCAndLaterCalls(t *testing.T) {
+  rng := rand.New(rand.NewSource(2))
+  param := KnnOptions()
+  param.Reference = randomMatrix(rng, 200, 3)
+  param.K = 3
+  distances, neighbors, model, err := KnnWithError(param)
+  if err != nil {
+    t.Fatal(err)
+  }
+  defer model.Close()
+  savedDistances := mat.DenseCopyOf(distances)
+  savedNeighbors := mat.DenseCopyOf(neighbors)
+
+  runtime.GC()
+  param = KnnOptions()
+  param.Reference = randomMatrix(rng, 200, 3)
+  param.K = 3
+  otherDistances, _, otherModel, err := KnnWithError(param)
+  if err != nil {
+    t.Fatal(err)
+  }
+  otherModel.Close()
+  runtime.GC()
+
+  if !mat.Equal(distances, savedDistances) {
+    t.Error("distances changed after GC and another Knn call")
+  }
+  if !mat.Equal(neighbors, savedNeighbors) {
+    t.Error("neighbors changed after GC and another Knn call")
+  }
+  if mat.Equal(distances, otherDistances) {
+    t.Error("two Knn calls on different data returned the same distances")
+  }
+}
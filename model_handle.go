@@ -0,0 +1,99 @@
+package mlpack
+
+import (
+  "runtime"
+  "sync"
+  "unsafe"
+)
+
+// A modelHandle owns a C++ model object.  All copies of a model value share
+// the same handle, so the object is deleted exactly once: either by an
+// explicit Close(), or by the finalizer once no copy is reachable anymore.
+//
+// Bindings may modify the model they are given, so busy is held while a
+// binding call or Save() uses the object: calls sharing a model run one after
+// the other, and the object is not deleted while it is in use.
+type modelHandle struct {
+  busy sync.Mutex
+  mu sync.Mutex
+  mem unsafe.Pointer
+  free func(unsafe.Pointer)
+}
+
+// Takes ownership of the given C++ model object, which is deleted with free.
+func newModelHandle(mem unsafe.Pointer,
+                    free func(unsafe.Pointer)) *modelHandle {
+  if mem == nil {
+    return nil
+  }
+  h := &modelHandle{mem: mem, free: free}
+  runtime.SetFinalizer(h, (*modelHandle).close)
+  return h
+}
+
+// Returns the C++ model object, or nil if there is none or it was deleted.
+func (h *modelHandle) pointer() unsafe.Pointer {
+  if h == nil {
+    return nil
+  }
+  h.mu.Lock()
+  defer h.mu.Unlock()
+  return h.mem
+}
+
+// Waits until the C++ model object is not in use and returns it, or returns
+// nil if there is none.  The object must be given back with release().
+func (h *modelHandle) acquire() unsafe.Pointer {
+  if h == nil {
+    return nil
+  }
+  h.busy.Lock()
+  return h.pointer()
+}
+
+// Gives back the object returned by acquire().
+func (h *modelHandle) release() {
+  if h != nil {
+    h.busy.Unlock()
+  }
+}
+
+// Deletes the C++ model object, unless it was already deleted.
+func (h *modelHandle) close() {
+  if h == nil {
+    return
+  }
+  h.busy.Lock()
+  defer h.busy.Unlock()
+  h.mu.Lock()
+  defer h.mu.Unlock()
+  if h.mem != nil {
+    h.free(h.mem)
+    h.mem = nil
+  }
+  runtime.SetFinalizer(h, nil)
+}
+
+// Replaces the C++ model object with mem, waiting until the old one is not in
+// use and deleting it.  All copies of the model share the handle, so they all
+// see the new object, even if the handle was closed.  A nil handle returns a
+// new one instead.
+func (h *modelHandle) replace(mem unsafe.Pointer,
+                              free func(unsafe.Pointer)) *modelHandle {
+  if h == nil {
+    return newModelHandle(mem, free)
+  }
+  h.busy.Lock()
+  defer h.busy.Unlock()
+  h.mu.Lock()
+  defer h.mu.Unlock()
+  if h.mem != nil {
+    h.free(h.mem)
+  } else {
+    // close() removed the finalizer.
+    runtime.SetFinalizer(h, (*modelHandle).close)
+  }
+  h.mem = mem
+  h.free = free
+  return h
+}
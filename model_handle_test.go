@@ -0,0 +1,81 @@
+package mlpack
+
+import (
+  "testing"
+  "time"
+  "unsafe"
+)
+
+// Returns a fake C++ model object and a free function that counts how often
+// each object is deleted.
+func fakeModels() (func() unsafe.Pointer, func(unsafe.Pointer),
+                   map[unsafe.Pointer]int) {
+  freed := make(map[unsafe.Pointer]int)
+  alloc := func() unsafe.Pointer { return unsafe.Pointer(new(int)) }
+  free := func(p unsafe.Pointer) { freed[p]++ }
+  return alloc, free, freed
+}
+
+func TestModelHandleReplace(t *testing.T) {
+  alloc, free, freed := fakeModels()
+
+  var h *modelHandle
+  first := alloc()
+  h = h.replace(first, free)
+  if h == nil || h.pointer() != first {
+    t.Fatal("replace() on a nil handle did not return a new handle")
+  }
+
+  // Copies of a model share the handle, so they see the new object.
+  copied := h
+  second := alloc()
+  if got := h.replace(second, free); got != h {
+    t.Error("replace() on a live handle returned another handle")
+  }
+  if copied.pointer() != second {
+    t.Error("a copy of the model does not see the replaced object")
+  }
+  if freed[first] != 1 {
+    t.Errorf("the old object was deleted %d times, want 1", freed[first])
+  }
+
+  h.close()
+  third := alloc()
+  h.replace(third, free)
+  if copied.pointer() != third {
+    t.Error("replace() after close() did not set the new object")
+  }
+  h.close()
+  h.close()
+  if freed[second] != 1 || freed[third] != 1 {
+    t.Errorf("the objects were deleted %d and %d times, want 1 each",
+        freed[second], freed[third])
+  }
+}
+
+func TestModelHandleReplaceWaitsForRelease(t *testing.T) {
+  alloc, free, _ := fakeModels()
+  old := alloc()
+  h := newModelHandle(old, free)
+  defer h.close()
+
+  if h.acquire() != old {
+    t.Fatal("acquire() did not return the object")
+  }
+  done := make(chan struct{})
+  go func() {
+    h.replace(alloc(), free)
+    close(done)
+  }()
+
+  select {
+  case <-done:
+    t.Fatal("replace() deleted an object that was in use")
+  case <-time.After(50 * time.Millisecond):
+  }
+  h.release()
+  <-done
+  if h.pointer() == old {
+    t.Error("replace() did not set the new object")
+  }
+}
@@ -0,0 +1,70 @@
+package mlpack
+
+import (
+  "bytes"
+  "fmt"
+  "io"
+  "io/ioutil"
+)
+
+// Format selects the archive format used when a model is saved.  These are the
+// cereal archive formats used by mlpack itself, so a model saved by the mlpack
+// command-line programs or the Python bindings can be loaded from Go, and the
+// other way around.
+type Format int
+
+const (
+  // FormatBinary is cereal's portable binary archive format.
+  FormatBinary Format = iota
+  // FormatJSON is cereal's JSON archive format.
+  FormatJSON
+  // FormatXML is cereal's XML archive format.
+  FormatXML
+)
+
+// String returns the name of the format.
+func (f Format) String() string {
+  switch f {
+  case FormatBinary:
+    return "binary"
+  case FormatJSON:
+    return "json"
+  case FormatXML:
+    return "xml"
+  }
+  return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// Reads a complete serialized model from r and detects the format it was saved
+// with.  JSON archives start with '{' and XML archives with '<'; anything else
+// is treated as a binary archive.
+func readModel(r io.Reader, typeName string) ([]byte, Format, error) {
+  data, err := ioutil.ReadAll(r)
+  if err != nil {
+    return nil, FormatBinary, err
+  }
+  if len(data) == 0 {
+    return nil, FormatBinary, fmt.Errorf("mlpack: no %s data to load",
+        typeName)
+  }
+
+  trimmed := bytes.TrimLeft(data, " \t\r\n\xef\xbb\xbf")
+  if len(trimmed) > 0 && trimmed[0] == '{' {
+    return data, FormatJSON, nil
+  }
+  if len(trimmed) > 0 && trimmed[0] == '<' {
+    return data, FormatXML, nil
+  }
+  return data, FormatBinary, nil
+}
+
+// Returns the error reported when the C side fails to deserialize a model.
+func loadError(typeName string, format Format) error {
+  return fmt.Errorf("mlpack: could not load %s from %s archive", typeName,
+      format)
+}
+
+// Returns the error reported when saving a model that holds no C++ object.
+func emptyModelError(typeName string) error {
+  return fmt.Errorf("mlpack: cannot save empty %s", typeName)
+}
@@ -0,0 +1,153 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "bytes"
+  "io/ioutil"
+  "math/rand"
+  "os"
+  "os/exec"
+  "path/filepath"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Runs Knn with the given model on query and returns the neighbors.
+func knnNeighbors(t *testing.T, model *KNNModel, query *mat.Dense) *mat.Dense {
+  t.Helper()
+  param := KnnOptions()
+  param.InputModel = model
+  param.Query = query
+  param.K = 3
+  _, neighbors, _, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("Knn() with the model failed: %v", err)
+  }
+  return neighbors
+}
+
+func TestModelSaveLoad(t *testing.T) {
+  rng := rand.New(rand.NewSource(1))
+  query := randomMatrix(rng, 20, 4)
+  param := KnnOptions()
+  param.Reference = randomMatrix(rng, 100, 4)
+  _, _, model, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("training Knn failed: %v", err)
+  }
+  defer model.Close()
+  want := knnNeighbors(t, &model, query)
+
+  for _, format := range []Format{FormatBinary, FormatJSON, FormatXML} {
+    var buf bytes.Buffer
+    if err := model.Save(&buf, format); err != nil {
+      t.Errorf("Save(%s) failed: %v", format, err)
+      continue
+    }
+    var loaded KNNModel
+    if err := loaded.Load(&buf); err != nil {
+      t.Errorf("Load() of a %s archive failed: %v", format, err)
+      continue
+    }
+    if got := knnNeighbors(t, &loaded, query); !mat.Equal(got, want) {
+      t.Errorf("the model loaded from a %s archive finds other neighbors",
+          format)
+    }
+    loaded.Close()
+  }
+}
+
+func TestModelLoadReplaces(t *testing.T) {
+  rng := rand.New(rand.NewSource(2))
+  param := KnnOptions()
+  param.Reference = randomMatrix(rng, 50, 3)
+  _, _, model, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("training Knn failed: %v", err)
+  }
+  defer model.Close()
+  var buf bytes.Buffer
+  if err := model.Save(&buf, FormatBinary); err != nil {
+    t.Fatalf("Save() failed: %v", err)
+  }
+
+  // A copy shares the model, so it sees the loaded one; a failed Load()
+  // leaves it unchanged.
+  copied := model
+  if err := model.Load(bytes.NewReader(buf.Bytes())); err != nil {
+    t.Fatalf("Load() failed: %v", err)
+  }
+  if err := model.Load(bytes.NewReader([]byte("{ not a model"))); err == nil {
+    t.Error("Load() of a broken archive succeeded")
+  }
+  knnNeighbors(t, &copied, randomMatrix(rng, 5, 3))
+}
+
+// Loads a model saved by the knn command-line program, which is skipped if it
+// is not installed.
+func TestModelLoadCLIArchive(t *testing.T) {
+  program, err := exec.LookPath("mlpack_knn")
+  if err != nil {
+    t.Skip("mlpack_knn is not installed")
+  }
+  dir, err := ioutil.TempDir("", "mlpack-go")
+  if err != nil {
+    t.Fatal(err)
+  }
+  defer os.RemoveAll(dir)
+
+  rng := rand.New(rand.NewSource(3))
+  reference := randomMatrix(rng, 60, 3)
+  query := randomMatrix(rng, 10, 3)
+  referenceFile := filepath.Join(dir, "reference.csv")
+  f, err := os.Create(referenceFile)
+  if err != nil {
+    t.Fatal(err)
+  }
+  w := NewMatrixWriter(f)
+  err = w.Write(reference)
+  if err == nil {
+    err = w.Flush()
+  }
+  if cerr := f.Close(); err == nil {
+    err = cerr
+  }
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  param := KnnOptions()
+  param.Reference = reference
+  _, _, trained, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("training Knn failed: %v", err)
+  }
+  defer trained.Close()
+  want := knnNeighbors(t, &trained, query)
+
+  for _, name := range []string{"model.bin", "model.json", "model.xml"} {
+    modelFile := filepath.Join(dir, name)
+    cmd := exec.Command(program, "-r", referenceFile, "-M", modelFile)
+    if out, err := cmd.CombinedOutput(); err != nil {
+      t.Fatalf("%s failed: %v\n%s", program, err, out)
+    }
+    f, err := os.Open(modelFile)
+    if err != nil {
+      t.Fatal(err)
+    }
+    var model KNNModel
+    err = model.Load(f)
+    f.Close()
+    if err != nil {
+      t.Errorf("Load() of %s failed: %v", name, err)
+      continue
+    }
+    if got := knnNeighbors(t, &model, query); !mat.Equal(got, want) {
+      t.Errorf("the model of %s finds other neighbors than one trained from Go",
+          name)
+    }
+    model.Close()
+  }
+}
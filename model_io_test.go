@@ -0,0 +1,34 @@
+package mlpack
+
+import (
+  "strings"
+  "testing"
+)
+
+func TestReadModel(t *testing.T) {
+  tests := []struct {
+    data string
+    format Format
+  }{
+    {"{\n  \"model\": {}\n}", FormatJSON},
+    {"\xef\xbb\xbf  {}", FormatJSON},
+    {"<?xml version=\"1.0\"?>", FormatXML},
+    {"\n<cereal/>", FormatXML},
+    {"\x01\x00\x00\x00", FormatBinary},
+  }
+  for _, test := range tests {
+    data, format, err := readModel(strings.NewReader(test.data), "KNNModel")
+    if err != nil {
+      t.Errorf("readModel(%q) failed: %v", test.data, err)
+      continue
+    }
+    if format != test.format || string(data) != test.data {
+      t.Errorf("readModel(%q) = %q, %s; want the data unchanged and %s",
+          test.data, data, format, test.format)
+    }
+  }
+
+  if _, _, err := readModel(strings.NewReader(""), "KNNModel"); err == nil {
+    t.Error("readModel() of no data succeeded")
+  }
+}
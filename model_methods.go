@@ -0,0 +1,405 @@
+package mlpack
+
+import "gonum.org/v1/gonum/mat"
+
+// The methods in this file give the trained model types a direct interface to
+// the bindings they are used with.  Each method passes the model back through
+// the binding's InputModel parameter, so the results are identical to calling
+// the binding by hand.  In every input matrix, each row is a single point.
+
+// Wraps x into a matrixWithInfo whose dimensions are all numeric.
+func numericDataAndInfo(x mat.Matrix) *matrixWithInfo {
+  if x == nil {
+    return DataAndInfo()
+  }
+  _, c := x.Dims()
+  return &matrixWithInfo{
+    Categoricals: make([]bool, c),
+    Data: x,
+  }
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *AdaBoostModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class probabilities of each point in x.
+func (m *AdaBoostModel) PredictProba(x mat.Matrix) (*mat.Dense, error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *AdaBoostModel) classify(x mat.Matrix) (*mat.Dense, *mat.Dense,
+                                                error) {
+  param := AdaboostOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, probabilities, err := AdaboostWithError(param)
+  return predictions, probabilities, err
+}
+
+// Search returns the distances to and indices of the k furthest neighbors of
+// each point in query.
+func (m *ApproxKFNModel) Search(query mat.Matrix, k int) (*mat.Dense,
+                                                         *mat.Dense, error) {
+  param := ApproxKfnOptions()
+  param.InputModel = m
+  param.Query = query
+  param.K = k
+  distances, neighbors, _, err := ApproxKfnWithError(param)
+  return distances, neighbors, err
+}
+
+// Predict returns the predicted response of each point in x.
+func (m *BayesianLinearRegressionModel) Predict(x mat.Matrix) (*mat.Dense,
+                                                               error) {
+  predictions, _, err := m.PredictStd(x)
+  return predictions, err
+}
+
+// PredictStd returns the predicted response of each point in x along with the
+// standard deviation of each prediction.
+func (m *BayesianLinearRegressionModel) PredictStd(x mat.Matrix) (*mat.Dense,
+                                                                  *mat.Dense,
+                                                                  error) {
+  param := BayesianLinearRegressionOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, stds, err := BayesianLinearRegressionWithError(param)
+  return predictions, stds, err
+}
+
+// Recommend returns the given number of recommendations for each user listed
+// in users.
+func (m *CFModel) Recommend(users mat.Matrix,
+                            recommendations int) (*mat.Dense, error) {
+  param := CfOptions()
+  param.InputModel = m
+  param.Query = users
+  param.Recommendations = recommendations
+  output, _, err := CfWithError(param)
+  return output, err
+}
+
+// Predict returns the predicted label of each point in x.  All dimensions of x
+// are treated as numeric.
+func (m *DecisionTreeModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class probabilities of each point in x.  All
+// dimensions of x are treated as numeric.
+func (m *DecisionTreeModel) PredictProba(x mat.Matrix) (*mat.Dense, error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *DecisionTreeModel) classify(x mat.Matrix) (*mat.Dense, *mat.Dense,
+                                                    error) {
+  param := DecisionTreeOptions()
+  param.InputModel = m
+  param.Test = numericDataAndInfo(x)
+  _, predictions, probabilities, err := DecisionTreeWithError(param)
+  return predictions, probabilities, err
+}
+
+// Predict returns the estimated density of each point in x.
+func (m *DTreeModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  param := DetOptions()
+  param.InputModel = m
+  param.Test = x
+  _, _, _, testSetEstimates, _, _, err := DetWithError(param)
+  return testSetEstimates, err
+}
+
+// Search returns the indices and kernel values of the k points with the
+// largest kernel value for each point in query.
+func (m *FastMKSModel) Search(query mat.Matrix, k int) (*mat.Dense,
+                                                       *mat.Dense, error) {
+  param := FastmksOptions()
+  param.InputModel = m
+  param.Query = query
+  param.K = k
+  indices, kernels, _, err := FastmksWithError(param)
+  return indices, kernels, err
+}
+
+// Probability returns the probability of each point in x under the model.
+func (m *GMMModel) Probability(x mat.Matrix) (*mat.Dense, error) {
+  return GmmProbabilityWithError(x, m, GmmProbabilityOptions())
+}
+
+// Generate returns the given number of samples drawn from the model.
+func (m *GMMModel) Generate(samples int) (*mat.Dense, error) {
+  return GmmGenerateWithError(m, samples, GmmGenerateOptions())
+}
+
+// LogLikelihood returns the log-likelihood of the observation sequence seq.
+func (m *HMMModel) LogLikelihood(seq mat.Matrix) (float64, error) {
+  return HmmLoglikWithError(seq, m, HmmLoglikOptions())
+}
+
+// Viterbi returns the most probable hidden state sequence for the observation
+// sequence seq.
+func (m *HMMModel) Viterbi(seq mat.Matrix) (*mat.Dense, error) {
+  return HmmViterbiWithError(seq, m, HmmViterbiOptions())
+}
+
+// Generate returns an observation sequence of the given length along with the
+// hidden states that produced it.
+func (m *HMMModel) Generate(length int) (*mat.Dense, *mat.Dense, error) {
+  return HmmGenerateWithError(length, m, HmmGenerateOptions())
+}
+
+// Predict returns the predicted label of each point in x.  All dimensions of x
+// are treated as numeric.
+func (m *HoeffdingTreeModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the probability of the predicted label of each point
+// in x.  All dimensions of x are treated as numeric.
+func (m *HoeffdingTreeModel) PredictProba(x mat.Matrix) (*mat.Dense, error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *HoeffdingTreeModel) classify(x mat.Matrix) (*mat.Dense, *mat.Dense,
+                                                     error) {
+  param := HoeffdingTreeOptions()
+  param.InputModel = m
+  param.Test = numericDataAndInfo(x)
+  _, predictions, probabilities, err := HoeffdingTreeWithError(param)
+  return predictions, probabilities, err
+}
+
+// Predict returns the estimated density of each point in x.
+func (m *KDEModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  param := KdeOptions()
+  param.InputModel = m
+  param.Query = x
+  _, predictions, err := KdeWithError(param)
+  return predictions, err
+}
+
+// Search returns the distances to and indices of the k furthest neighbors of
+// each point in query.
+func (m *KFNModel) Search(query mat.Matrix, k int) (*mat.Dense, *mat.Dense,
+                                                   error) {
+  param := KfnOptions()
+  param.InputModel = m
+  param.Query = query
+  param.K = k
+  distances, neighbors, _, err := KfnWithError(param)
+  return distances, neighbors, err
+}
+
+// Search returns the distances to and indices of the k nearest neighbors of
+// each point in query.
+func (m *KNNModel) Search(query mat.Matrix, k int) (*mat.Dense, *mat.Dense,
+                                                   error) {
+  param := KnnOptions()
+  param.InputModel = m
+  param.Query = query
+  param.K = k
+  distances, neighbors, _, err := KnnWithError(param)
+  return distances, neighbors, err
+}
+
+// Search returns the distances to and indices of the k approximate nearest
+// neighbors of each point in query.
+func (m *LSHSearchModel) Search(query mat.Matrix, k int) (*mat.Dense,
+                                                         *mat.Dense, error) {
+  param := LshOptions()
+  param.InputModel = m
+  param.Query = query
+  param.K = k
+  distances, neighbors, _, err := LshWithError(param)
+  return distances, neighbors, err
+}
+
+// Search returns the distances to and indices of the k rank-approximate
+// nearest neighbors of each point in query.
+func (m *RAModel) Search(query mat.Matrix, k int) (*mat.Dense, *mat.Dense,
+                                                  error) {
+  param := KrannOptions()
+  param.InputModel = m
+  param.Query = query
+  param.K = k
+  distances, neighbors, _, err := KrannWithError(param)
+  return distances, neighbors, err
+}
+
+// Predict returns the predicted response of each point in x.
+func (m *LARSModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  param := LarsOptions()
+  param.InputModel = m
+  param.Test = x
+  _, outputPredictions, err := LarsWithError(param)
+  return outputPredictions, err
+}
+
+// Predict returns the predicted response of each point in x.
+func (m *LinearRegressionModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  param := LinearRegressionOptions()
+  param.InputModel = m
+  param.Test = x
+  _, outputPredictions, err := LinearRegressionWithError(param)
+  return outputPredictions, err
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *LinearSVMModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class scores of each point in x.
+func (m *LinearSVMModel) PredictProba(x mat.Matrix) (*mat.Dense, error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *LinearSVMModel) classify(x mat.Matrix) (*mat.Dense, *mat.Dense,
+                                                 error) {
+  param := LinearSvmOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, probabilities, err := LinearSvmWithError(param)
+  return predictions, probabilities, err
+}
+
+// Transform returns the codes of each point in x over the learned dictionary.
+func (m *LocalCoordinateCodingModel) Transform(x mat.Matrix) (*mat.Dense,
+                                                              error) {
+  param := LocalCoordinateCodingOptions()
+  param.InputModel = m
+  param.Test = x
+  codes, _, _, err := LocalCoordinateCodingWithError(param)
+  return codes, err
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *LogisticRegressionModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class probabilities of each point in x.
+func (m *LogisticRegressionModel) PredictProba(x mat.Matrix) (*mat.Dense,
+                                                              error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *LogisticRegressionModel) classify(x mat.Matrix) (*mat.Dense,
+                                                          *mat.Dense, error) {
+  param := LogisticRegressionOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, probabilities, err := LogisticRegressionWithError(param)
+  return predictions, probabilities, err
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *NBCModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class probabilities of each point in x.
+func (m *NBCModel) PredictProba(x mat.Matrix) (*mat.Dense, error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *NBCModel) classify(x mat.Matrix) (*mat.Dense, *mat.Dense, error) {
+  param := NbcOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, probabilities, err := NbcWithError(param)
+  return predictions, probabilities, err
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *PerceptronModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  param := PerceptronOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, err := PerceptronWithError(param)
+  return predictions, err
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *RandomForestModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class probabilities of each point in x.
+func (m *RandomForestModel) PredictProba(x mat.Matrix) (*mat.Dense, error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *RandomForestModel) classify(x mat.Matrix) (*mat.Dense, *mat.Dense,
+                                                    error) {
+  param := RandomForestOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, probabilities, err := RandomForestWithError(param)
+  return predictions, probabilities, err
+}
+
+// Transform scales each point in x with the fitted scaler.
+func (m *ScalingModel) Transform(x mat.Matrix) (*mat.Dense, error) {
+  param := PreprocessScaleOptions()
+  param.InputModel = m
+  output, _, err := PreprocessScaleWithError(x, param)
+  return output, err
+}
+
+// InverseTransform undoes the scaling of each point in x.
+func (m *ScalingModel) InverseTransform(x mat.Matrix) (*mat.Dense, error) {
+  param := PreprocessScaleOptions()
+  param.InputModel = m
+  param.InverseScaling = true
+  output, _, err := PreprocessScaleWithError(x, param)
+  return output, err
+}
+
+// Predict returns the predicted label of each point in x.
+func (m *SoftmaxRegressionModel) Predict(x mat.Matrix) (*mat.Dense, error) {
+  predictions, _, err := m.classify(x)
+  return predictions, err
+}
+
+// PredictProba returns the class probabilities of each point in x.
+func (m *SoftmaxRegressionModel) PredictProba(x mat.Matrix) (*mat.Dense,
+                                                             error) {
+  _, probabilities, err := m.classify(x)
+  return probabilities, err
+}
+
+func (m *SoftmaxRegressionModel) classify(x mat.Matrix) (*mat.Dense,
+                                                         *mat.Dense, error) {
+  param := SoftmaxRegressionOptions()
+  param.InputModel = m
+  param.Test = x
+  _, predictions, probabilities, err := SoftmaxRegressionWithError(param)
+  return predictions, probabilities, err
+}
+
+// Transform returns the sparse codes of each point in x over the learned
+// dictionary.
+func (m *SparseCodingModel) Transform(x mat.Matrix) (*mat.Dense, error) {
+  param := SparseCodingOptions()
+  param.InputModel = m
+  param.Test = x
+  codes, _, _, err := SparseCodingWithError(param)
+  return codes, err
+}
@@ -1,588 +1,373 @@
-package mlpack
-
-/*
-#include <capi/approx_kfn.h>
-#include <capi/bayesian_linear_regression.h>
-#include <capi/cf.h>
-#include <capi/decision_tree.h>
-#include <capi/det.h>
-#include <capi/fastmks.h>
-#include <capi/gmm_train.h>
-#include <capi/gmm_generate.h>
-#include <capi/gmm_probability.h>
-#include <capi/hmm_train.h>
-#include <capi/hmm_generate.h>
-#include <capi/hmm_loglik.h>
-#include <capi/hmm_viterbi.h>
-#include <capi/hoeffding_tree.h>
-#include <capi/kde.h>
-#include <capi/lars.h>
-#include <capi/linear_svm.h>
-#include <capi/local_coordinate_coding.h>
-#include <capi/logistic_regression.h>
-#include <capi/lsh.h>
-#include <capi/nbc.h>
-#include <capi/knn.h>
-#include <capi/kfn.h>
-#include <capi/perceptron.h>
-#include <capi/preprocess_scale.h>
-#include <capi/random_forest.h>
-#include <capi/krann.h>
-#include <capi/softmax_regression.h>
-#include <capi/sparse_coding.h>
-#include <capi/adaboost.h>
-#include <capi/linear_regression.h>
-*/
-import "C"
-
-import (
-  "runtime"
-  "unsafe"
-)
-
-type approxkfnModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *approxkfnModel) allocApproxKFNModel(params *params, identifier string) {
-  m.mem = C.mlpackGetApproxKFNModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *approxkfnModel) getApproxKFNModel(params *params, identifier string) {
-  m.allocApproxKFNModel(params, identifier)
-}
-
-func setApproxKFNModel(params* params,
-                           identifier string,
-                           ptr *approxkfnModel) {
-  C.mlpackSetApproxKFNModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type bayesianLinearRegression struct {
-  mem unsafe.Pointer 
-}
-
-func (m *bayesianLinearRegression) allocBayesianLinearRegression(params *params, identifier string) {
-  m.mem = C.mlpackGetBayesianLinearRegressionPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *bayesianLinearRegression) getBayesianLinearRegression(params *params, identifier string) {
-  m.allocBayesianLinearRegression(params, identifier)
-}
-
-func setBayesianLinearRegression(params* params,
-                           identifier string,
-                           ptr *bayesianLinearRegression) {
-  C.mlpackSetBayesianLinearRegressionPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type cfModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *cfModel) allocCFModel(params *params, identifier string) {
-  m.mem = C.mlpackGetCFModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *cfModel) getCFModel(params *params, identifier string) {
-  m.allocCFModel(params, identifier)
-}
-
-func setCFModel(params* params,
-                           identifier string,
-                           ptr *cfModel) {
-  C.mlpackSetCFModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
+// Code generated by mlpack-gen. DO NOT EDIT.
 
-type decisionTreeModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *decisionTreeModel) allocDecisionTreeModel(params *params, identifier string) {
-  m.mem = C.mlpackGetDecisionTreeModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *decisionTreeModel) getDecisionTreeModel(params *params, identifier string) {
-  m.allocDecisionTreeModel(params, identifier)
-}
-
-func setDecisionTreeModel(params* params,
-                           identifier string,
-                           ptr *decisionTreeModel) {
-  C.mlpackSetDecisionTreeModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type dTree struct {
-  mem unsafe.Pointer 
-}
-
-func (m *dTree) allocDTree(params *params, identifier string) {
-  m.mem = C.mlpackGetDTreePtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *dTree) getDTree(params *params, identifier string) {
-  m.allocDTree(params, identifier)
-}
-
-func setDTree(params* params,
-                           identifier string,
-                           ptr *dTree) {
-  C.mlpackSetDTreePtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type fastmksModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *fastmksModel) allocFastMKSModel(params *params, identifier string) {
-  m.mem = C.mlpackGetFastMKSModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *fastmksModel) getFastMKSModel(params *params, identifier string) {
-  m.allocFastMKSModel(params, identifier)
-}
-
-func setFastMKSModel(params* params,
-                           identifier string,
-                           ptr *fastmksModel) {
-  C.mlpackSetFastMKSModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type gmm struct {
-  mem unsafe.Pointer 
-}
-
-func (m *gmm) allocGMM(params *params, identifier string) {
-  m.mem = C.mlpackGetGMMPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *gmm) getGMM(params *params, identifier string) {
-  m.allocGMM(params, identifier)
-}
-
-func setGMM(params* params,
-                           identifier string,
-                           ptr *gmm) {
-  C.mlpackSetGMMPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type hmmModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *hmmModel) allocHMMModel(params *params, identifier string) {
-  m.mem = C.mlpackGetHMMModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *hmmModel) getHMMModel(params *params, identifier string) {
-  m.allocHMMModel(params, identifier)
-}
-
-func setHMMModel(params* params,
-                           identifier string,
-                           ptr *hmmModel) {
-  C.mlpackSetHMMModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type hoeffdingTreeModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *hoeffdingTreeModel) allocHoeffdingTreeModel(params *params, identifier string) {
-  m.mem = C.mlpackGetHoeffdingTreeModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *hoeffdingTreeModel) getHoeffdingTreeModel(params *params, identifier string) {
-  m.allocHoeffdingTreeModel(params, identifier)
-}
-
-func setHoeffdingTreeModel(params* params,
-                           identifier string,
-                           ptr *hoeffdingTreeModel) {
-  C.mlpackSetHoeffdingTreeModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type kdeModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *kdeModel) allocKDEModel(params *params, identifier string) {
-  m.mem = C.mlpackGetKDEModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *kdeModel) getKDEModel(params *params, identifier string) {
-  m.allocKDEModel(params, identifier)
-}
-
-func setKDEModel(params* params,
-                           identifier string,
-                           ptr *kdeModel) {
-  C.mlpackSetKDEModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type lars struct {
-  mem unsafe.Pointer 
-}
-
-func (m *lars) allocLARS(params *params, identifier string) {
-  m.mem = C.mlpackGetLARSPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *lars) getLARS(params *params, identifier string) {
-  m.allocLARS(params, identifier)
-}
-
-func setLARS(params* params,
-                           identifier string,
-                           ptr *lars) {
-  C.mlpackSetLARSPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type linearsvmModel struct {
-  mem unsafe.Pointer 
-}
-
-func (m *linearsvmModel) allocLinearSVMModel(params *params, identifier string) {
-  m.mem = C.mlpackGetLinearSVMModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *linearsvmModel) getLinearSVMModel(params *params, identifier string) {
-  m.allocLinearSVMModel(params, identifier)
-}
-
-func setLinearSVMModel(params* params,
-                           identifier string,
-                           ptr *linearsvmModel) {
-  C.mlpackSetLinearSVMModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
-
-type localCoordinateCoding struct {
-  mem unsafe.Pointer 
-}
-
-func (m *localCoordinateCoding) allocLocalCoordinateCoding(params *params, identifier string) {
-  m.mem = C.mlpackGetLocalCoordinateCodingPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
-}
-
-func (m *localCoordinateCoding) getLocalCoordinateCoding(params *params, identifier string) {
-  m.allocLocalCoordinateCoding(params, identifier)
-}
-
-func setLocalCoordinateCoding(params* params,
-                           identifier string,
-                           ptr *localCoordinateCoding) {
-  C.mlpackSetLocalCoordinateCodingPtr(params.mem,
-      C.CString(identifier), ptr.mem)
-}
+package mlpack
 
-type logisticRegression struct {
-  mem unsafe.Pointer 
+// ApproxKFNModel holds a trained mlpack ApproxKFNModel.  It is returned by
+// ApproxKfn() and can be passed back to ApproxKfn().
+type ApproxKFNModel struct {
+  handle *modelHandle
 }
 
-func (m *logisticRegression) allocLogisticRegression(params *params, identifier string) {
-  m.mem = C.mlpackGetLogisticRegressionPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *ApproxKFNModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *logisticRegression) getLogisticRegression(params *params, identifier string) {
-  m.allocLogisticRegression(params, identifier)
+// BayesianLinearRegressionModel holds a trained mlpack
+// BayesianLinearRegression.  It is returned by BayesianLinearRegression() and
+// can be passed back to BayesianLinearRegression().
+type BayesianLinearRegressionModel struct {
+  handle *modelHandle
 }
 
-func setLogisticRegression(params* params,
-                           identifier string,
-                           ptr *logisticRegression) {
-  C.mlpackSetLogisticRegressionPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *BayesianLinearRegressionModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type lshSearch struct {
-  mem unsafe.Pointer 
+// CFModel holds a trained mlpack CFModel.  It is returned by Cf() and can be
+// passed back to Cf().
+type CFModel struct {
+  handle *modelHandle
 }
 
-func (m *lshSearch) allocLSHSearch(params *params, identifier string) {
-  m.mem = C.mlpackGetLSHSearchPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *CFModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *lshSearch) getLSHSearch(params *params, identifier string) {
-  m.allocLSHSearch(params, identifier)
+// DecisionTreeModel holds a trained mlpack DecisionTreeModel.  It is returned
+// by DecisionTree() and can be passed back to DecisionTree().
+type DecisionTreeModel struct {
+  handle *modelHandle
 }
 
-func setLSHSearch(params* params,
-                           identifier string,
-                           ptr *lshSearch) {
-  C.mlpackSetLSHSearchPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *DecisionTreeModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type nbcModel struct {
-  mem unsafe.Pointer 
+// DTreeModel holds a trained mlpack DTree.  It is returned by Det() and can be
+// passed back to Det().
+type DTreeModel struct {
+  handle *modelHandle
 }
 
-func (m *nbcModel) allocNBCModel(params *params, identifier string) {
-  m.mem = C.mlpackGetNBCModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *DTreeModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *nbcModel) getNBCModel(params *params, identifier string) {
-  m.allocNBCModel(params, identifier)
+// FastMKSModel holds a trained mlpack FastMKSModel.  It is returned by
+// Fastmks() and can be passed back to Fastmks().
+type FastMKSModel struct {
+  handle *modelHandle
 }
 
-func setNBCModel(params* params,
-                           identifier string,
-                           ptr *nbcModel) {
-  C.mlpackSetNBCModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *FastMKSModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type knnModel struct {
-  mem unsafe.Pointer 
+// GMMModel holds a trained mlpack GMM.  It is returned by GmmTrain() and can be
+// passed back to GmmGenerate(), GmmProbability() and GmmTrain().
+type GMMModel struct {
+  handle *modelHandle
 }
 
-func (m *knnModel) allocKNNModel(params *params, identifier string) {
-  m.mem = C.mlpackGetKNNModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *GMMModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *knnModel) getKNNModel(params *params, identifier string) {
-  m.allocKNNModel(params, identifier)
+// HMMModel holds a trained mlpack HMMModel.  It is returned by HmmTrain() and
+// can be passed back to HmmGenerate(), HmmLoglik(), HmmTrain() and
+// HmmViterbi().
+type HMMModel struct {
+  handle *modelHandle
 }
 
-func setKNNModel(params* params,
-                           identifier string,
-                           ptr *knnModel) {
-  C.mlpackSetKNNModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *HMMModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type kfnModel struct {
-  mem unsafe.Pointer 
+// HoeffdingTreeModel holds a trained mlpack HoeffdingTreeModel.  It is returned
+// by HoeffdingTree() and can be passed back to HoeffdingTree().
+type HoeffdingTreeModel struct {
+  handle *modelHandle
 }
 
-func (m *kfnModel) allocKFNModel(params *params, identifier string) {
-  m.mem = C.mlpackGetKFNModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *HoeffdingTreeModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *kfnModel) getKFNModel(params *params, identifier string) {
-  m.allocKFNModel(params, identifier)
+// KDEModel holds a trained mlpack KDEModel.  It is returned by Kde() and can be
+// passed back to Kde().
+type KDEModel struct {
+  handle *modelHandle
 }
 
-func setKFNModel(params* params,
-                           identifier string,
-                           ptr *kfnModel) {
-  C.mlpackSetKFNModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *KDEModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type perceptronModel struct {
-  mem unsafe.Pointer 
+// LARSModel holds a trained mlpack LARS.  It is returned by Lars() and can be
+// passed back to Lars().
+type LARSModel struct {
+  handle *modelHandle
 }
 
-func (m *perceptronModel) allocPerceptronModel(params *params, identifier string) {
-  m.mem = C.mlpackGetPerceptronModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *LARSModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *perceptronModel) getPerceptronModel(params *params, identifier string) {
-  m.allocPerceptronModel(params, identifier)
+// LinearSVMModel holds a trained mlpack LinearSVMModel.  It is returned by
+// LinearSvm() and can be passed back to LinearSvm().
+type LinearSVMModel struct {
+  handle *modelHandle
 }
 
-func setPerceptronModel(params* params,
-                           identifier string,
-                           ptr *perceptronModel) {
-  C.mlpackSetPerceptronModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *LinearSVMModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type scalingModel struct {
-  mem unsafe.Pointer 
+// LocalCoordinateCodingModel holds a trained mlpack LocalCoordinateCoding.  It
+// is returned by LocalCoordinateCoding() and can be passed back to
+// LocalCoordinateCoding().
+type LocalCoordinateCodingModel struct {
+  handle *modelHandle
 }
 
-func (m *scalingModel) allocScalingModel(params *params, identifier string) {
-  m.mem = C.mlpackGetScalingModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *LocalCoordinateCodingModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *scalingModel) getScalingModel(params *params, identifier string) {
-  m.allocScalingModel(params, identifier)
+// LogisticRegressionModel holds a trained mlpack LogisticRegression.  It is
+// returned by LogisticRegression() and can be passed back to
+// LogisticRegression().
+type LogisticRegressionModel struct {
+  handle *modelHandle
 }
 
-func setScalingModel(params* params,
-                           identifier string,
-                           ptr *scalingModel) {
-  C.mlpackSetScalingModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *LogisticRegressionModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type randomForestModel struct {
-  mem unsafe.Pointer 
+// LSHSearchModel holds a trained mlpack LSHSearch.  It is returned by Lsh() and
+// can be passed back to Lsh().
+type LSHSearchModel struct {
+  handle *modelHandle
 }
 
-func (m *randomForestModel) allocRandomForestModel(params *params, identifier string) {
-  m.mem = C.mlpackGetRandomForestModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *LSHSearchModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *randomForestModel) getRandomForestModel(params *params, identifier string) {
-  m.allocRandomForestModel(params, identifier)
+// NBCModel holds a trained mlpack NBCModel.  It is returned by Nbc() and can be
+// passed back to Nbc().
+type NBCModel struct {
+  handle *modelHandle
 }
 
-func setRandomForestModel(params* params,
-                           identifier string,
-                           ptr *randomForestModel) {
-  C.mlpackSetRandomForestModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *NBCModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type raModel struct {
-  mem unsafe.Pointer 
+// KNNModel holds a trained mlpack KNNModel.  It is returned by Knn() and can be
+// passed back to Knn().
+type KNNModel struct {
+  handle *modelHandle
 }
 
-func (m *raModel) allocRAModel(params *params, identifier string) {
-  m.mem = C.mlpackGetRAModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *KNNModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *raModel) getRAModel(params *params, identifier string) {
-  m.allocRAModel(params, identifier)
+// KFNModel holds a trained mlpack KFNModel.  It is returned by Kfn() and can be
+// passed back to Kfn().
+type KFNModel struct {
+  handle *modelHandle
 }
 
-func setRAModel(params* params,
-                           identifier string,
-                           ptr *raModel) {
-  C.mlpackSetRAModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *KFNModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type softmaxRegression struct {
-  mem unsafe.Pointer 
+// PerceptronModel holds a trained mlpack PerceptronModel.  It is returned by
+// Perceptron() and can be passed back to Perceptron().
+type PerceptronModel struct {
+  handle *modelHandle
 }
 
-func (m *softmaxRegression) allocSoftmaxRegression(params *params, identifier string) {
-  m.mem = C.mlpackGetSoftmaxRegressionPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *PerceptronModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *softmaxRegression) getSoftmaxRegression(params *params, identifier string) {
-  m.allocSoftmaxRegression(params, identifier)
+// ScalingModel holds a trained mlpack ScalingModel.  It is returned by
+// PreprocessScale() and can be passed back to PreprocessScale().
+type ScalingModel struct {
+  handle *modelHandle
 }
 
-func setSoftmaxRegression(params* params,
-                           identifier string,
-                           ptr *softmaxRegression) {
-  C.mlpackSetSoftmaxRegressionPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *ScalingModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type sparseCoding struct {
-  mem unsafe.Pointer 
+// RandomForestModel holds a trained mlpack RandomForestModel.  It is returned
+// by RandomForest() and can be passed back to RandomForest().
+type RandomForestModel struct {
+  handle *modelHandle
 }
 
-func (m *sparseCoding) allocSparseCoding(params *params, identifier string) {
-  m.mem = C.mlpackGetSparseCodingPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *RandomForestModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *sparseCoding) getSparseCoding(params *params, identifier string) {
-  m.allocSparseCoding(params, identifier)
+// RAModel holds a trained mlpack RAModel.  It is returned by Krann() and can be
+// passed back to Krann().
+type RAModel struct {
+  handle *modelHandle
 }
 
-func setSparseCoding(params* params,
-                           identifier string,
-                           ptr *sparseCoding) {
-  C.mlpackSetSparseCodingPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *RAModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type adaBoostModel struct {
-  mem unsafe.Pointer 
+// SoftmaxRegressionModel holds a trained mlpack SoftmaxRegression.  It is
+// returned by SoftmaxRegression() and can be passed back to
+// SoftmaxRegression().
+type SoftmaxRegressionModel struct {
+  handle *modelHandle
 }
 
-func (m *adaBoostModel) allocAdaBoostModel(params *params, identifier string) {
-  m.mem = C.mlpackGetAdaBoostModelPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *SoftmaxRegressionModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *adaBoostModel) getAdaBoostModel(params *params, identifier string) {
-  m.allocAdaBoostModel(params, identifier)
+// SparseCodingModel holds a trained mlpack SparseCoding.  It is returned by
+// SparseCoding() and can be passed back to SparseCoding().
+type SparseCodingModel struct {
+  handle *modelHandle
 }
 
-func setAdaBoostModel(params* params,
-                           identifier string,
-                           ptr *adaBoostModel) {
-  C.mlpackSetAdaBoostModelPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *SparseCodingModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-type linearRegression struct {
-  mem unsafe.Pointer 
+// AdaBoostModel holds a trained mlpack AdaBoostModel.  It is returned by
+// Adaboost() and can be passed back to Adaboost().
+type AdaBoostModel struct {
+  handle *modelHandle
 }
 
-func (m *linearRegression) allocLinearRegression(params *params, identifier string) {
-  m.mem = C.mlpackGetLinearRegressionPtr(params.mem,
-      C.CString(identifier))
-  runtime.KeepAlive(m)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *AdaBoostModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
-func (m *linearRegression) getLinearRegression(params *params, identifier string) {
-  m.allocLinearRegression(params, identifier)
+// LinearRegressionModel holds a trained mlpack LinearRegression.  It is
+// returned by LinearRegression() and can be passed back to LinearRegression().
+type LinearRegressionModel struct {
+  handle *modelHandle
 }
 
-func setLinearRegression(params* params,
-                           identifier string,
-                           ptr *linearRegression) {
-  C.mlpackSetLinearRegressionPtr(params.mem,
-      C.CString(identifier), ptr.mem)
+// Close deletes the C++ model.  Copies of the model share it, so none of them
+// may be used afterwards.  Calling Close more than once is safe, and models
+// that are never closed are deleted once they become unreachable.
+func (m *LinearRegressionModel) Close() error {
+  m.handle.close()
+  return nil
 }
 
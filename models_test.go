@@ -0,0 +1,117 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "math/rand"
+  "testing"
+)
+
+// Trains a KNNModel on random data.
+func trainKNNModel(t *testing.T, rng *rand.Rand) KNNModel {
+  t.Helper()
+  param := KnnOptions()
+  param.Reference = randomMatrix(rng, 40, 3)
+  _, _, model, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("training Knn failed: %v", err)
+  }
+  return model
+}
+
+func TestModelCloseTwice(t *testing.T) {
+  model := trainKNNModel(t, rand.New(rand.NewSource(1)))
+  copied := model
+  if err := model.Close(); err != nil {
+    t.Errorf("Close() failed: %v", err)
+  }
+  if err := model.Close(); err != nil {
+    t.Errorf("second Close() failed: %v", err)
+  }
+  if err := copied.Close(); err != nil {
+    t.Errorf("Close() of a copy failed: %v", err)
+  }
+  if model.handle.pointer() != nil {
+    t.Error("the model still holds a C++ object after Close()")
+  }
+}
+
+func TestModelUseAfterClose(t *testing.T) {
+  rng := rand.New(rand.NewSource(2))
+  model := trainKNNModel(t, rng)
+  model.Close()
+
+  param := KnnOptions()
+  param.InputModel = &model
+  param.Query = randomMatrix(rng, 5, 3)
+  param.K = 1
+  _, _, _, err := KnnWithError(param)
+  berr, ok := err.(*BindingError)
+  if !ok {
+    t.Fatalf("Knn() with a closed model returned %v, want a *BindingError",
+        err)
+  }
+  if berr.Binding != "knn" || berr.Param != "input_model" {
+    t.Errorf("the error is for %s/%s, want knn/input_model", berr.Binding,
+        berr.Param)
+  }
+}
+
+func TestModelSharedWithOutput(t *testing.T) {
+  rng := rand.New(rand.NewSource(3))
+  model := trainKNNModel(t, rng)
+  defer model.Close()
+
+  param := KnnOptions()
+  param.InputModel = &model
+  param.Query = randomMatrix(rng, 5, 3)
+  param.K = 1
+  _, _, output, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("Knn() with a model failed: %v", err)
+  }
+  // knn returns its input model, so the output shares its handle.
+  if output.handle != model.handle {
+    t.Fatal("the output model does not share the handle of the input model")
+  }
+  output.Close()
+  if model.handle.pointer() != nil {
+    t.Error("closing the output model did not close the shared input model")
+  }
+}
+
+func TestOwnModel(t *testing.T) {
+  alloc, free, freed := fakeModels()
+  input := newModelHandle(alloc(), free)
+  defer input.close()
+
+  p := &params{binding: "knn"}
+  mem := useModel(p, "input_model", input)
+  if mem == nil || p.err != nil {
+    t.Fatalf("useModel() failed: %v", p.err)
+  }
+  if h := ownModel(p, mem, free); h != input {
+    t.Error("ownModel() of the input object did not return its handle")
+  }
+  other := alloc()
+  h := ownModel(p, other, free)
+  if h == input || h.pointer() != other {
+    t.Error("ownModel() of a new object did not return a new handle")
+  }
+  releaseModels(p)
+  h.close()
+  if freed[other] != 1 || freed[mem] != 0 {
+    t.Error("the new object was not deleted once, or the input was deleted")
+  }
+
+  // useModel() of a closed model records an error instead of blocking.
+  input.close()
+  p = &params{binding: "knn"}
+  if useModel(p, "input_model", input) != nil || p.err == nil {
+    t.Error("useModel() of a closed model did not record an error")
+  }
+  if input.acquire() != nil {
+    t.Error("useModel() of a closed model kept it busy")
+  }
+  input.release()
+}
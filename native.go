@@ -0,0 +1,9 @@
+// +build !nomlpack
+
+package mlpack
+
+// The backend that calls the mlpack libraries.  Its methods are defined in the
+// <binding>_native.go files.
+type nativeBackend struct{}
+
+var defaultBackend Backend = nativeBackend{}
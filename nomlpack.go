@@ -0,0 +1,40 @@
+// +build nomlpack
+
+package mlpack
+
+import (
+  "io"
+  "runtime"
+)
+
+// Without the mlpack libraries, every binding returns ErrNotAvailable until
+// SetBackend() is called.
+var defaultBackend Backend = UnavailableBackend{}
+
+// Without OpenMP, the default is the number of CPUs.
+func defaultNumThreads() int {
+  return runtime.NumCPU()
+}
+
+func libraryVersion() string {
+  return ""
+}
+
+// SetLogOutput does nothing in builds with the nomlpack tag.
+func SetLogOutput(info, warn io.Writer) {
+}
+
+// Bindings returns nil in builds with the nomlpack tag.
+func Bindings() []string {
+  return nil
+}
+
+// DescribeBinding returns ErrNotAvailable.
+func DescribeBinding(name string) (BindingSpec, error) {
+  return BindingSpec{}, ErrNotAvailable
+}
+
+// Run returns ErrNotAvailable.
+func Run(binding string, in map[string]interface{}) (map[string]interface{}, error) {
+  return nil, ErrNotAvailable
+}
@@ -0,0 +1,49 @@
+// +build nomlpack
+
+package mlpack
+
+import (
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+func TestIntrospectionWithoutMlpack(t *testing.T) {
+  if v := Version(); v != "" {
+    t.Errorf("Version() = %q without the mlpack libraries", v)
+  }
+  if err := CheckVersion(); err != ErrNotAvailable {
+    t.Errorf("CheckVersion() = %v, want ErrNotAvailable", err)
+  }
+  if names := Bindings(); names != nil {
+    t.Errorf("Bindings() = %v without the mlpack libraries", names)
+  }
+  if _, err := DescribeBinding("knn"); err != ErrNotAvailable {
+    t.Errorf("DescribeBinding() = %v, want ErrNotAvailable", err)
+  }
+}
+
+// The WithError forms return ErrNotAvailable, and the plain forms panic with
+// it.
+func TestBindingsWithoutMlpack(t *testing.T) {
+  param := KnnOptions()
+  if _, _, _, err := KnnWithError(param); err != ErrNotAvailable {
+    t.Errorf("KnnWithError() = %v, want ErrNotAvailable", err)
+  }
+  err := PreprocessDescribeWithError(mat.NewDense(1, 1, nil),
+      PreprocessDescribeOptions())
+  if err != ErrNotAvailable {
+    t.Errorf("PreprocessDescribeWithError() = %v, want ErrNotAvailable", err)
+  }
+  if _, err := Run("knn", nil); err != ErrNotAvailable {
+    t.Errorf("Run() = %v, want ErrNotAvailable", err)
+  }
+
+  defer func() {
+    if r := recover(); r != ErrNotAvailable {
+      t.Errorf("Knn() panicked with %v, want ErrNotAvailable", r)
+    }
+  }()
+  Knn(param)
+  t.Error("Knn() did not panic")
+}
@@ -2,20 +2,21 @@ package mlpack
 
 import (
     "encoding/csv"
+    "fmt"
     "io"
+    "math"
     "os"
     "strconv"
+    "strings"
     "net/http"
     "compress/gzip"
     "gonum.org/v1/gonum/mat"
 )
 
-// Load() reads all of the numeric records from the CSV.
+// Load() reads all of the numeric records from the CSV.  A first line that is
+// not numeric is taken as a header and skipped; use LoadCSV() to get its column
+// names or to change how the file is parsed.
 func Load(filename string) (*mat.Dense, error) {
-  var elements int
-  var rows int
-  var numbers []float64
-
   // Open the file.
   file, err := os.Open(filename)
   if err != nil {
@@ -23,29 +24,306 @@ func Load(filename string) (*mat.Dense, error) {
   }
   defer file.Close()
 
-  lines, err := csv.NewReader(file).ReadAll()
+  output, _, err := LoadCSV(file, CSVOptions{})
+  return output, err
+}
+
+// CSVHeader selects whether the first record of a CSV file is a header.
+type CSVHeader int
+
+const (
+  // CSVHeaderDetect takes the first record as a header if any of its cells is
+  // neither a number nor a missing value.
+  CSVHeaderDetect CSVHeader = iota
+  // CSVHeaderPresent always takes the first record as a header.
+  CSVHeaderPresent
+  // CSVHeaderAbsent reads the first record as data.
+  CSVHeaderAbsent
+)
+
+// CSVOptions configures LoadCSV().  The zero value reads comma-separated
+// values and detects the header.
+type CSVOptions struct {
+  // Comma is the field delimiter, e.g. '\t' or ';'.  0 means ','.
+  Comma rune
+  // Comment, if not 0, starts lines that are skipped.
+  Comment rune
+  // Header selects whether the first record holds the column names.
+  Header CSVHeader
+  // Missing lists cell values, besides empty cells and "NA", that are read as
+  // NaN, e.g. "?" or "null".
+  Missing []string
+  // LazyQuotes allows quotes in unquoted cells and unescaped quotes in quoted
+  // cells, as in encoding/csv.
+  LazyQuotes bool
+}
+
+// CSVError reports a cell or record of a CSV file that LoadCSV() cannot read.
+type CSVError struct {
+  // Row is the number of the record, starting at 1 and counting the header
+  // and any blank lines.
+  Row int
+  // Column is the number of the cell in the record, starting at 1, or 0 if the
+  // error concerns the whole record.
+  Column int
+  // Message describes the problem.
+  Message string
+}
+
+// Error implements the error interface.
+func (e *CSVError) Error() string {
+  if e.Column > 0 {
+    return fmt.Sprintf("mlpack: CSV row %d, column %d: %s", e.Row, e.Column,
+        e.Message)
+  }
+  return fmt.Sprintf("mlpack: CSV row %d: %s", e.Row, e.Message)
+}
+
+// LoadCSV reads a numeric CSV file from r, with each record as a row of the
+// returned matrix.  It also returns the column names if the file has a header,
+// or nil otherwise.  Cells may be quoted, and surrounding spaces are ignored.
+// Empty cells, "NA" and the values in opts.Missing are read as NaN.  In a
+// file with a single column, a blank line is an empty cell, unless it is at
+// the end of the file; other files skip blank lines.
+//
+// Records with a different number of cells than the first one, and cells that
+// are not numbers, are reported as a *CSVError.  Malformed quoting is reported
+// as the *csv.ParseError of encoding/csv.
+func LoadCSV(r io.Reader, opts CSVOptions) (*mat.Dense, []string, error) {
+  in, err := gunzipIfNeeded(r)
   if err != nil {
-    return nil, err
+    return nil, nil, err
+  }
+  parser := newCSVParser(in, opts)
+  var data []float64
+  rows := 0
+  for {
+    data, err = parser.next(data)
+    if err == io.EOF {
+      break
+    }
+    if err != nil {
+      return nil, nil, err
+    }
+    rows++
   }
-  
-  var str_err error
-  for _, args := range lines[0:] {
-    for _, arg := range args {
-      n, err := strconv.ParseFloat(arg, 64)
-      str_err = err 
-      if err == nil {
-        numbers = append(numbers, n)
-        elements = elements + 1
+
+  if rows == 0 || parser.cols == 0 {
+    return nil, nil, fmt.Errorf("mlpack: CSV has no data")
+  }
+  return mat.NewDense(rows, parser.cols, data), parser.names, nil
+}
+
+// A csvParser reads the numeric records of a CSV file one at a time.
+type csvParser struct {
+  reader *csv.Reader
+  header CSVHeader
+  missing map[string]bool
+  // The column names, or nil if the file has no header.
+  names []string
+  // The number of cells in each record, known once the first one is read.
+  cols int
+  // The number of records read so far, including the header and blank lines.
+  row int
+  // The number of blank lines read before held, in a single-column file.
+  blanks int
+  // A copy of the record that follows those blank lines.
+  held []string
+}
+
+func newCSVParser(r io.Reader, opts CSVOptions) *csvParser {
+  comma := opts.Comma
+  if comma == 0 {
+    comma = ','
+  }
+  reader := csv.NewReader(&blankLineReader{in: r, comma: comma,
+      comment: opts.Comment})
+  reader.Comma = comma
+  reader.Comment = opts.Comment
+  reader.LazyQuotes = opts.LazyQuotes
+  // Ragged records are reported by next(), with their row number.
+  reader.FieldsPerRecord = -1
+  reader.ReuseRecord = true
+
+  missing := map[string]bool{"": true, "NA": true}
+  for _, m := range opts.Missing {
+    missing[m] = true
+  }
+  return &csvParser{reader: reader, header: opts.Header, missing: missing}
+}
+
+// Reads the next data record and appends its values to data.  Returns io.EOF
+// once there are no more records.
+func (p *csvParser) next(data []float64) ([]float64, error) {
+  if p.blanks > 0 && p.held != nil {
+    p.blanks--
+    return append(data, math.NaN()), nil
+  }
+  record := p.held
+  p.held = nil
+  for record == nil {
+    var err error
+    if record, err = p.reader.Read(); err != nil {
+      // Blank lines at the end of the file are dropped.
+      return data, err
+    }
+    p.row++
+    if len(record) == 1 && record[0] == blankCell {
+      // A blank line is only a row once a record follows it.
+      if p.cols == 1 {
+        p.blanks++
       }
+      record = nil
+    } else if p.blanks > 0 {
+      p.held = append([]string(nil), record...)
+      p.blanks--
+      return append(data, math.NaN()), nil
     }
-    if str_err == nil {
-      rows = rows + 1
+  }
+  if p.cols == 0 {
+    p.cols = len(record)
+    if isCSVHeader(record, p.header, p.missing) {
+      p.names = make([]string, p.cols)
+      for i, cell := range record {
+        p.names[i] = strings.TrimSpace(cell)
+      }
+      return p.next(data)
     }
   }
-  data := numbers[:elements]
-  columns := elements/rows
-  output := mat.NewDense(rows , columns, data)
-  return output, nil
+  if len(record) != p.cols {
+    return data, &CSVError{Row: p.row, Message: fmt.Sprintf("has %d " +
+        "cells, but the first row has %d", len(record), p.cols)}
+  }
+  for i, cell := range record {
+    cell = strings.TrimSpace(cell)
+    if p.missing[cell] {
+      data = append(data, math.NaN())
+      continue
+    }
+    n, err := strconv.ParseFloat(cell, 64)
+    if err != nil {
+      return data, &CSVError{Row: p.row, Column: i + 1,
+          Message: fmt.Sprintf("%q is not a number", cell)}
+    }
+    data = append(data, n)
+  }
+  return data, nil
+}
+
+// Reports whether the first record of a CSV file is its header.
+func isCSVHeader(record []string, header CSVHeader,
+                 missing map[string]bool) bool {
+  switch header {
+  case CSVHeaderPresent:
+    return true
+  case CSVHeaderAbsent:
+    return false
+  }
+  for _, cell := range record {
+    cell = strings.TrimSpace(cell)
+    if missing[cell] {
+      continue
+    }
+    if _, err := strconv.ParseFloat(cell, 64); err != nil {
+      return true
+    }
+  }
+  return false
+}
+
+// The cell that blankLineReader puts on blank lines.
+const blankCell = "\x00"
+
+// A blankLineReader passes a CSV file through, but replaces each blank line
+// outside quoted cells with a line holding only blankCell.  encoding/csv
+// skips blank lines, which would drop the empty cells of a file with a single
+// column and shift the rows after them.
+type blankLineReader struct {
+  in io.Reader
+  comma rune
+  comment rune
+  err error
+  buf []byte
+  // The output not returned yet is out[pos:].
+  out []byte
+  pos int
+  // The state after the bytes read so far.
+  started bool // A line has been started.
+  commented bool // The line is a comment.
+  fieldStart bool // The next byte starts a cell.
+  quoted bool // Inside a quoted cell.
+  closed bool // A quoted cell was just closed, so a quote escapes it.
+  cr bool // A '\r' at the start of a line was held back.
+}
+
+func (r *blankLineReader) Read(b []byte) (int, error) {
+  for r.pos == len(r.out) {
+    r.out = r.out[:0]
+    r.pos = 0
+    if r.err != nil {
+      if !r.cr {
+        return 0, r.err
+      }
+      r.cr = false
+      r.out = append(r.out, '\r')
+      break
+    }
+    if r.buf == nil {
+      r.buf = make([]byte, 4096)
+    }
+    var n int
+    n, r.err = r.in.Read(r.buf)
+    for _, c := range r.buf[:n] {
+      r.scan(c)
+    }
+  }
+  n := copy(b, r.out[r.pos:])
+  r.pos += n
+  return n, nil
+}
+
+// Appends c to the output, or a blank line if c ends a blank line.
+func (r *blankLineReader) scan(c byte) {
+  if !r.started && !r.quoted {
+    if r.cr {
+      r.cr = false
+      if c == '\n' {
+        r.out = append(r.out, blankCell + "\r\n"...)
+        return
+      }
+      r.out = append(r.out, '\r')
+    }
+    switch c {
+    case '\n':
+      r.out = append(r.out, blankCell + "\n"...)
+      return
+    case '\r':
+      r.cr = true
+      return
+    }
+    r.started = true
+    r.commented = r.comment != 0 && rune(c) == r.comment
+    r.fieldStart = true
+  }
+  r.out = append(r.out, c)
+
+  fieldStart, closed := r.fieldStart, r.closed
+  r.fieldStart, r.closed = false, false
+  switch {
+  case r.commented:
+    r.started = c != '\n'
+  case r.quoted:
+    if c == '"' {
+      r.quoted = false
+      r.closed = true
+    }
+  case c == '"' && (fieldStart || closed):
+    r.quoted = true
+  case c == '\n':
+    r.started = false
+  case rune(c) == r.comma:
+    r.fieldStart = true
+  }
 }
 
 // Save() writes all of the records to the CSV.
@@ -57,24 +335,16 @@ func Save(filename string, mat *mat.Dense) error {
   }
   defer file.Close()
 
-  writer := csv.NewWriter(file)
-  defer writer.Flush()
-
-  if mat != nil {
-    rows, cols := mat.Dims()
-    for i := 0; i < rows; i++ {
-      var strings []string
-      for j := 0; j < cols; j++ {
-        s := strconv.FormatFloat(mat.At(i,j), 'e', 16, 64)
-        strings = append(strings, s)
-      }
-      err := writer.Write(strings)
-      if err != nil {
-        return err
-      }
-    }
+  if mat == nil {
+    return nil
+  }
+  writer := NewMatrixWriter(file)
+  writer.Format = 'e'
+  writer.Precision = 16
+  if err := writer.Write(mat); err != nil {
+    return err
   }
-  return nil
+  return writer.Flush()
 }
 
 // UnZip() unzips the given input to the given output file.
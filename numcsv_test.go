@@ -0,0 +1,160 @@
+package mlpack
+
+import (
+  "bytes"
+  "compress/gzip"
+  "math"
+  "reflect"
+  "strings"
+  "testing"
+  "testing/iotest"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Compares a matrix with the expected rows, where NaN equals NaN.
+func checkRows(t *testing.T, got *mat.Dense, want [][]float64) {
+  t.Helper()
+  r, c := got.Dims()
+  if r != len(want) || (r > 0 && c != len(want[0])) {
+    t.Fatalf("got a %dx%d matrix, want %d rows of %d", r, c, len(want),
+        len(want[0]))
+  }
+  for i, row := range want {
+    for j, w := range row {
+      g := got.At(i, j)
+      if g != w && !(math.IsNaN(g) && math.IsNaN(w)) {
+        t.Errorf("element (%d, %d) = %v, want %v", i, j, g, w)
+      }
+    }
+  }
+}
+
+func TestLoadCSVHeader(t *testing.T) {
+  nan := math.NaN()
+  tests := []struct {
+    name string
+    in string
+    header CSVHeader
+    names []string
+    rows [][]float64
+  }{
+    {"detected", "x, y\n1,2\n3,4\n", CSVHeaderDetect, []string{"x", "y"},
+        [][]float64{{1, 2}, {3, 4}}},
+    {"numeric first row", "1,2\n3,4\n", CSVHeaderDetect, nil,
+        [][]float64{{1, 2}, {3, 4}}},
+    {"missing values are not a header", "NA,2\n3,\n", CSVHeaderDetect, nil,
+        [][]float64{{nan, 2}, {3, nan}}},
+    {"present", "1,2\n3,4\n", CSVHeaderPresent, []string{"1", "2"},
+        [][]float64{{3, 4}}},
+    {"absent", "1,2\n3,4\n", CSVHeaderAbsent, nil,
+        [][]float64{{1, 2}, {3, 4}}},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      got, names, err := LoadCSV(strings.NewReader(test.in),
+          CSVOptions{Header: test.header})
+      if err != nil {
+        t.Fatal(err)
+      }
+      if !reflect.DeepEqual(names, test.names) {
+        t.Errorf("names = %q, want %q", names, test.names)
+      }
+      checkRows(t, got, test.rows)
+    })
+  }
+}
+
+func TestLoadCSVMissing(t *testing.T) {
+  nan := math.NaN()
+  in := "a;b;c\n1;;NA\n? ;\"\"; 4\n"
+  got, _, err := LoadCSV(strings.NewReader(in),
+      CSVOptions{Comma: ';', Missing: []string{"?"}})
+  if err != nil {
+    t.Fatal(err)
+  }
+  checkRows(t, got, [][]float64{{1, nan, nan}, {nan, nan, 4}})
+}
+
+func TestLoadCSVBlankLines(t *testing.T) {
+  nan := math.NaN()
+  tests := []struct {
+    name string
+    in string
+    rows [][]float64
+  }{
+    {"single column", "a\n1\n\n3\n", [][]float64{{1}, {nan}, {3}}},
+    {"crlf", "a\r\n1\r\n\r\n\r\n3\r\n", [][]float64{{1}, {nan}, {nan}, {3}}},
+    {"trailing", "a\n1\n\n3\n\n\n", [][]float64{{1}, {nan}, {3}}},
+    {"leading", "\n\n1\n\n2", [][]float64{{1}, {nan}, {2}}},
+    {"several columns", "1,2\n\n3,4\n", [][]float64{{1, 2}, {3, 4}}},
+    {"comment", "# a\n\n1\n# b\n\n2\n", [][]float64{{1}, {nan}, {2}}},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      got, _, err := LoadCSV(strings.NewReader(test.in),
+          CSVOptions{Comment: '#'})
+      if err != nil {
+        t.Fatal(err)
+      }
+      checkRows(t, got, test.rows)
+
+      // Lines split across reads, such as "\r" and "\n", give the same rows.
+      got, _, err = LoadCSV(iotest.OneByteReader(strings.NewReader(test.in)),
+          CSVOptions{Comment: '#'})
+      if err != nil {
+        t.Fatal(err)
+      }
+      checkRows(t, got, test.rows)
+    })
+  }
+}
+
+// Blank lines inside quoted cells are part of the cell.
+func TestLoadCSVQuotedBlankLine(t *testing.T) {
+  in := "x,\"y\"\"\n\ny\"\n1,2\n"
+  _, names, err := LoadCSV(strings.NewReader(in), CSVOptions{})
+  if err != nil {
+    t.Fatal(err)
+  }
+  if want := []string{"x", "y\"\n\ny"}; !reflect.DeepEqual(names, want) {
+    t.Errorf("names = %q, want %q", names, want)
+  }
+}
+
+func TestLoadCSVErrors(t *testing.T) {
+  tests := []struct {
+    name string
+    in string
+    row, column int
+  }{
+    {"not a number", "x,y\n1,2\n3,abc\n", 3, 2},
+    {"ragged", "1,2\n3\n", 2, 0},
+    {"after a blank line", "x\n1\n\nz\n", 4, 1},
+  }
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      _, _, err := LoadCSV(strings.NewReader(test.in), CSVOptions{})
+      csvErr, ok := err.(*CSVError)
+      if !ok {
+        t.Fatalf("got error %v, want a *CSVError", err)
+      }
+      if csvErr.Row != test.row || csvErr.Column != test.column {
+        t.Errorf("error at row %d, column %d, want row %d, column %d",
+            csvErr.Row, csvErr.Column, test.row, test.column)
+      }
+    })
+  }
+}
+
+func TestLoadCSVGzip(t *testing.T) {
+  var buf bytes.Buffer
+  w := gzip.NewWriter(&buf)
+  w.Write([]byte("1,2\n3,4\n"))
+  w.Close()
+  got, _, err := LoadCSV(&buf, CSVOptions{})
+  if err != nil {
+    t.Fatal(err)
+  }
+  checkRows(t, got, [][]float64{{1, 2}, {3, 4}})
+}
@@ -0,0 +1,32 @@
+package mlpack
+
+import "io"
+
+// A paramSet records which fields of an options struct were set through its
+// setter methods.  The bindings only forward a field to mlpack when it differs
+// from its default value, unless it is recorded here; this makes it possible
+// to pass a value such as Seed: 0 explicitly, which mlpack may treat
+// differently from leaving the parameter out.
+type paramSet map[string]bool
+
+// Marks the mlpack parameter with the given name as explicitly set.
+func (s *paramSet) mark(name string) {
+  if *s == nil {
+    *s = make(paramSet)
+  }
+  (*s)[name] = true
+}
+
+// Reports whether the mlpack parameter with the given name was explicitly set.
+func (s paramSet) has(name string) bool {
+  return s[name]
+}
+
+// LogOutput selects where the output of mlpack's log streams is written.
+// Informational output, which mlpack only produces when Verbose is set, goes
+// to Info; warnings go to Warn.  A nil writer means the process's stdout or
+// stderr, respectively.
+type LogOutput struct {
+  Info io.Writer
+  Warn io.Writer
+}
@@ -0,0 +1,134 @@
+package mlpack
+
+import (
+  "reflect"
+
+  "gonum.org/v1/gonum/blas/blas64"
+  "gonum.org/v1/gonum/mat"
+)
+
+// Returns whether m is nil or a nil pointer of any matrix type, whose methods
+// would panic.
+func isNilMatrix(m mat.Matrix) bool {
+  if m == nil {
+    return true
+  }
+  v := reflect.ValueOf(m)
+  return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// Returns the dimensions and the elements of m in row-major order, which is
+// the column-major layout of the transposed matrix that mlpack expects.  Dense
+// matrices with contiguous rows are passed without a copy; views with a larger
+// stride, transposes, vectors and all other matrix types are packed into a new
+// slice.  The slice is kept on the Params object so it stays alive for the
+// whole binding call.
+func packMatrix(p *params, identifier string, m mat.Matrix) (int, int,
+                                                            []float64) {
+  if isNilMatrix(m) {
+    setError(p, identifier, "matrix is nil")
+    return 0, 0, nil
+  }
+  r, c := m.Dims()
+  if r == 0 || c == 0 {
+    setError(p, identifier, "matrix is empty")
+    return 0, 0, nil
+  }
+
+  if r == 1 || c == 1 {
+    if data := packElements(m, r*c); data != nil {
+      p.inputs = append(p.inputs, data)
+      return r, c, data
+    }
+  }
+
+  var data []float64
+  if raw, ok := m.(mat.RawMatrixer); ok {
+    general := raw.RawMatrix()
+    if general.Stride == c {
+      data = general.Data[:r*c]
+    } else {
+      data = make([]float64, r*c)
+      for i := 0; i < r; i++ {
+        copy(data[i*c:(i+1)*c], general.Data[i*general.Stride:])
+      }
+    }
+  } else if raw, ok := untransposeRaw(m); ok {
+    // Transposes are copied straight from the memory of the original matrix,
+    // which is much faster than calling At() for every element.
+    data = make([]float64, r*c)
+    for j := 0; j < c; j++ {
+      row := raw.Data[j*raw.Stride:j*raw.Stride+r]
+      for i, v := range row {
+        data[i*c+j] = v
+      }
+    }
+  } else {
+    data = make([]float64, r*c)
+    for i := 0; i < r; i++ {
+      for j := 0; j < c; j++ {
+        data[i*c+j] = m.At(i, j)
+      }
+    }
+  }
+  p.inputs = append(p.inputs, data)
+  return r, c, data
+}
+
+// Returns the n elements of a row or column vector, or nil if m does not give
+// access to its memory.  A transposed vector has its elements in the same
+// order, so transposes are looked through.  Contiguous vectors, such as most
+// VecDense values, are used without copying.
+func packElements(m mat.Matrix, n int) []float64 {
+  if t, ok := m.(mat.Untransposer); ok {
+    m = t.Untranspose()
+  }
+  switch v := m.(type) {
+  case mat.RawVectorer:
+    raw := v.RawVector()
+    if raw.Inc == 1 {
+      return raw.Data[:n]
+    }
+    data := make([]float64, n)
+    for i := range data {
+      data[i] = raw.Data[i*raw.Inc]
+    }
+    return data
+  case mat.RawMatrixer:
+    raw := v.RawMatrix()
+    if raw.Rows == 1 || raw.Stride == 1 {
+      return raw.Data[:n]
+    }
+  }
+  return nil
+}
+
+// Returns the memory of the matrix that m is the transpose of, if there is
+// one.
+func untransposeRaw(m mat.Matrix) (blas64.General, bool) {
+  t, ok := m.(mat.Untransposer)
+  if !ok {
+    return blas64.General{}, false
+  }
+  raw, ok := t.Untranspose().(mat.RawMatrixer)
+  if !ok {
+    return blas64.General{}, false
+  }
+  return raw.RawMatrix(), true
+}
+
+// Packs a row or column vector for mlpack.  Either orientation is accepted,
+// since the elements are in the same order.
+func packVector(p *params, identifier string, m mat.Matrix) []float64 {
+  if isNilMatrix(m) {
+    setError(p, identifier, "matrix is nil")
+    return nil
+  }
+  r, c := m.Dims()
+  if r != 1 && c != 1 {
+    setError(p, identifier, "given matrix must have a single row or column")
+    return nil
+  }
+  _, _, data := packMatrix(p, identifier, m)
+  return data
+}
@@ -0,0 +1,260 @@
+package mlpack
+
+import (
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Returns the elements of m in row-major order, read with At().
+func rowMajor(m mat.Matrix) []float64 {
+  r, c := m.Dims()
+  data := make([]float64, 0, r*c)
+  for i := 0; i < r; i++ {
+    for j := 0; j < c; j++ {
+      data = append(data, m.At(i, j))
+    }
+  }
+  return data
+}
+
+// Returns whether a and b share their first element.
+func sameMemory(a, b []float64) bool {
+  return len(a) > 0 && len(b) > 0 && &a[0] == &b[0]
+}
+
+func equalFloats(a, b []float64) bool {
+  if len(a) != len(b) {
+    return false
+  }
+  for i := range a {
+    if a[i] != b[i] {
+      return false
+    }
+  }
+  return true
+}
+
+// Returns a rows x cols Dense matrix holding 1, 2, 3, ...
+func countingDense(rows, cols int) *mat.Dense {
+  data := make([]float64, rows*cols)
+  for i := range data {
+    data[i] = float64(i + 1)
+  }
+  return mat.NewDense(rows, cols, data)
+}
+
+func TestPackMatrix(t *testing.T) {
+  dense := countingDense(4, 5)
+  sym := mat.NewSymDense(3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6})
+  tests := []struct {
+    name string
+    m mat.Matrix
+  }{
+    {"Dense", dense},
+    {"slice with stride != cols", dense.Slice(1, 3, 1, 4)},
+    {"Transpose", dense.T()},
+    {"mat.Transpose", mat.Transpose{Matrix: dense}},
+    {"transposed slice", dense.Slice(0, 3, 2, 5).T()},
+    {"SymDense", sym},
+    {"column slice", dense.Slice(0, 4, 2, 3)},
+    {"row slice", dense.Slice(2, 3, 0, 5)},
+  }
+  for _, test := range tests {
+    p := &params{binding: "test"}
+    r, c, data := packMatrix(p, "input", test.m)
+    if p.err != nil {
+      t.Errorf("%s: packMatrix() failed: %v", test.name, p.err)
+      continue
+    }
+    wantR, wantC := test.m.Dims()
+    if r != wantR || c != wantC {
+      t.Errorf("%s: packMatrix() dimensions are %dx%d, want %dx%d", test.name,
+          r, c, wantR, wantC)
+    }
+    if want := rowMajor(test.m); !equalFloats(data, want) {
+      t.Errorf("%s: packMatrix() = %v, want %v", test.name, data, want)
+    }
+    if len(p.inputs) != 1 || !sameMemory(p.inputs[0], data) {
+      t.Errorf("%s: the packed data is not kept on the params", test.name)
+    }
+  }
+}
+
+func TestPackMatrixZeroCopy(t *testing.T) {
+  dense := countingDense(3, 4)
+  p := &params{binding: "test"}
+  if _, _, data := packMatrix(p, "input", dense); !sameMemory(data,
+      dense.RawMatrix().Data) {
+    t.Error("a contiguous Dense matrix was copied")
+  }
+  // Rows of a slice are contiguous, but not a whole slice.
+  slice := dense.Slice(1, 3, 1, 3).(*mat.Dense)
+  if _, _, data := packMatrix(p, "input", slice); sameMemory(data,
+      slice.RawMatrix().Data) {
+    t.Error("a slice with stride != cols was passed without packing")
+  }
+  row := dense.RowView(1)
+  if _, _, data := packMatrix(p, "input", row.T()); !sameMemory(data,
+      row.(*mat.VecDense).RawVector().Data) {
+    t.Error("a transposed contiguous vector was copied")
+  }
+}
+
+// A transpose whose elements can only be read from the memory of the original
+// matrix.
+type rawOnlyTranspose struct {
+  mat.Transpose
+}
+
+func (rawOnlyTranspose) At(i, j int) float64 {
+  panic("At() called")
+}
+
+func TestPackMatrixTransposeFastPath(t *testing.T) {
+  dense := countingDense(37, 53)
+  slice := dense.Slice(3, 30, 5, 50).(*mat.Dense)
+  for _, m := range []*mat.Dense{dense, slice} {
+    p := &params{binding: "test"}
+    r, c, data := packMatrix(p, "input",
+        rawOnlyTranspose{mat.Transpose{Matrix: m}})
+    wantR, wantC := m.T().Dims()
+    if p.err != nil || r != wantR || c != wantC {
+      t.Errorf("packMatrix() of a %dx%d transpose = %dx%d, %v", wantR, wantC,
+          r, c, p.err)
+      continue
+    }
+    if want := rowMajor(m.T()); !equalFloats(data, want) {
+      t.Errorf("packMatrix() of a %dx%d transpose = %v, want %v", wantR, wantC,
+          data, want)
+    }
+  }
+}
+
+func TestPackMatrixVectorZeroCopy(t *testing.T) {
+  vec := mat.NewVecDense(4, []float64{1, 2, 3, 4})
+  p := &params{binding: "test"}
+  if r, c, data := packMatrix(p, "input", vec); r != 4 || c != 1 ||
+      !sameMemory(data, vec.RawVector().Data) {
+    t.Error("a contiguous VecDense was copied")
+  }
+  dense := countingDense(3, 4)
+  row := dense.RowView(2).(*mat.VecDense)
+  if r, c, data := packMatrix(p, "input", row.T()); r != 1 || c != 4 ||
+      !sameMemory(data, row.RawVector().Data) ||
+      !equalFloats(data, []float64{9, 10, 11, 12}) {
+    t.Error("a transposed row of a Dense was copied")
+  }
+  column := dense.ColView(1)
+  if _, _, data := packMatrix(p, "input", column); sameMemory(data,
+      dense.RawMatrix().Data[1:]) || !equalFloats(data, []float64{2, 6, 10}) {
+    t.Error("a strided column was not packed")
+  }
+}
+
+func TestPackMatrixEmpty(t *testing.T) {
+  var nilDense *mat.Dense
+  var nilVec *mat.VecDense
+  tests := []struct {
+    name string
+    m mat.Matrix
+    message string
+  }{
+    {"nil", nil, "matrix is nil"},
+    {"nil Dense", nilDense, "matrix is nil"},
+    {"nil VecDense", nilVec, "matrix is nil"},
+    {"empty Dense", &mat.Dense{}, "matrix is empty"},
+    {"empty VecDense", &mat.VecDense{}, "matrix is empty"},
+  }
+  for _, test := range tests {
+    p := &params{binding: "test"}
+    if _, _, data := packMatrix(p, "input", test.m); data != nil {
+      t.Errorf("%s: packMatrix() returned data", test.name)
+    }
+    if p.err == nil || p.err.Param != "input" ||
+        p.err.Message != test.message {
+      t.Errorf("%s: packMatrix() error is %v, want %q for input", test.name,
+          p.err, test.message)
+    }
+  }
+}
+
+func TestPackElements(t *testing.T) {
+  strided := mat.NewVecDense(6, []float64{1, 2, 3, 4, 5, 6})
+  tests := []struct {
+    name string
+    m mat.Matrix
+    want []float64
+    copied bool
+  }{
+    {"VecDense", mat.NewVecDense(3, []float64{1, 2, 3}), []float64{1, 2, 3},
+        false},
+    {"VecDense with Inc > 1", countingDense(3, 2).ColView(1),
+        []float64{2, 4, 6}, true},
+    {"transposed VecDense", strided.SliceVec(1, 4).T(), []float64{2, 3, 4},
+        false},
+    {"row Dense", countingDense(1, 3), []float64{1, 2, 3}, false},
+    {"column Dense", countingDense(3, 1), []float64{1, 2, 3}, false},
+  }
+  for _, test := range tests {
+    r, c := test.m.Dims()
+    data := packElements(test.m, r*c)
+    if !equalFloats(data, test.want) {
+      t.Errorf("%s: packElements() = %v, want %v", test.name, data,
+          test.want)
+      continue
+    }
+    var raw []float64
+    switch v := test.m.(type) {
+    case mat.RawVectorer:
+      raw = v.RawVector().Data
+    case mat.RawMatrixer:
+      raw = v.RawMatrix().Data
+    default:
+      raw = test.m.(mat.Untransposer).Untranspose().(mat.RawVectorer).
+          RawVector().Data
+    }
+    if copied := !sameMemory(data, raw); copied != test.copied {
+      t.Errorf("%s: packElements() copied the data: %t, want %t", test.name,
+          copied, test.copied)
+    }
+  }
+
+  // Column slices of wider matrices have no contiguous memory.
+  if data := packElements(countingDense(3, 2).Slice(0, 3, 1, 2), 3);
+      data != nil {
+    t.Errorf("packElements() of a column slice = %v, want nil", data)
+  }
+  if data := packElements(mat.NewSymDense(1, []float64{1}), 1); data != nil {
+    t.Errorf("packElements() of a SymDense = %v, want nil", data)
+  }
+}
+
+func TestUntransposeRaw(t *testing.T) {
+  dense := countingDense(2, 3)
+  raw, ok := untransposeRaw(dense.T())
+  if !ok || !sameMemory(raw.Data, dense.RawMatrix().Data) ||
+      raw.Rows != 2 || raw.Cols != 3 {
+    t.Error("untransposeRaw() of a transposed Dense did not return its memory")
+  }
+  if _, ok := untransposeRaw(dense); ok {
+    t.Error("untransposeRaw() of a Dense succeeded")
+  }
+  sym := mat.NewSymDense(2, nil)
+  if _, ok := untransposeRaw(mat.Transpose{Matrix: sym}); ok {
+    t.Error("untransposeRaw() of a transposed SymDense succeeded")
+  }
+}
+
+func TestPackVector(t *testing.T) {
+  p := &params{binding: "test"}
+  if data := packVector(p, "labels", countingDense(2, 2)); data != nil ||
+      p.err == nil {
+    t.Error("packVector() of a 2x2 matrix did not fail")
+  }
+  p = &params{binding: "test"}
+  data := packVector(p, "labels", countingDense(1, 4).T())
+  if p.err != nil || !equalFloats(data, []float64{1, 2, 3, 4}) {
+    t.Errorf("packVector() of a transposed row = %v, %v", data, p.err)
+  }
+}
@@ -0,0 +1,26 @@
+package mlpack
+
+import "unsafe"
+
+// The state of a binding call on the Go side.  mem is the C++ Params object of
+// the call.
+type params struct {
+  mem unsafe.Pointer
+  binding string
+  err *BindingError
+  // Model handles passed as inputs, keyed by their C++ object.
+  models map[unsafe.Pointer]*modelHandle
+  // Input matrix data that mlpack refers to during the call.
+  inputs [][]float64
+  // Handles of Go values that C refers to during the call.
+  handles []uintptr
+}
+
+// Records an error detected on the Go side while setting the given parameter.
+// Only the first error is kept, and the binding is not called if one is set.
+func setError(p *params, identifier string, message string) {
+  if p.err == nil {
+    p.err = &BindingError{Binding: p.binding, Param: identifier,
+        Message: message}
+  }
+}
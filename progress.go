@@ -0,0 +1,52 @@
+// +build !nomlpack
+
+package mlpack
+
+/*
+#cgo CFLAGS: -I. -I/capi
+#include <capi/io_util.h>
+
+int mlpackGoProgress(uintptr_t handle, size_t iteration, double objective);
+*/
+import "C"
+
+import "fmt"
+
+// The progress function of a binding call, and the call it belongs to.
+type progress struct {
+  f func(iter int, objective float64) bool
+  p *params
+}
+
+// Reports the optimizer progress of the binding call made with the given
+// Params object to f, if it is not nil.
+func setProgress(p *params, f func(iter int, objective float64) bool) {
+  if f == nil {
+    return
+  }
+  h := newHandle(&progress{f: f, p: p})
+  p.handles = append(p.handles, h)
+  C.mlpackSetProgressCallback(p.mem,
+      C.mlpackProgressCallback(C.mlpackGoProgress), C.uintptr_t(h))
+}
+
+//export mlpackGoProgress
+func mlpackGoProgress(handle C.uintptr_t, iteration C.size_t,
+                      objective C.double) (result C.int) {
+  pr, ok := handleValue(uintptr(handle)).(*progress)
+  if !ok {
+    return 1
+  }
+  // A panic must not unwind through mlpack's C++ frames, so it stops the
+  // optimization and is reported as the error of the call instead.
+  defer func() {
+    if r := recover(); r != nil {
+      setError(pr.p, "progress", fmt.Sprint("progress function panicked: ", r))
+      result = 0
+    }
+  }()
+  if pr.f(int(iteration), float64(objective)) {
+    return 1
+  }
+  return 0
+}
@@ -0,0 +1,64 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "math/rand"
+  "testing"
+)
+
+func TestProgressStopsTraining(t *testing.T) {
+  rng := rand.New(rand.NewSource(8))
+  x := randomMatrix(rng, 60, 3)
+  train := func(stopAt int) (int, error) {
+    calls := 0
+    p := LmnnOptions()
+    p.Labels = alternatingLabels(60)
+    p.Optimizer = OptimizerLBFGS
+    p.MaxIterations = 50
+    p.Tolerance = 0
+    p.Progress = func(iter int, objective float64) bool {
+      calls++
+      if iter != calls {
+        t.Errorf("progress reported iteration %d as call %d", iter, calls)
+      }
+      return iter < stopAt
+    }
+    _, _, _, err := LmnnWithError(x, p)
+    return calls, err
+  }
+
+  all, err := train(1000)
+  if err != nil {
+    t.Fatalf("Lmnn() failed: %v", err)
+  }
+  if all <= 3 {
+    t.Skipf("Lmnn() converged after %d iterations", all)
+  }
+
+  // Stopping early is not an error; the model trained so far is returned.
+  calls, err := train(3)
+  if err != nil {
+    t.Fatalf("Lmnn() stopped by its progress function failed: %v", err)
+  }
+  if calls != 3 {
+    t.Errorf("progress was called %d times after returning false at " +
+        "iteration 3", calls)
+  }
+}
+
+func TestProgressPanic(t *testing.T) {
+  rng := rand.New(rand.NewSource(9))
+  p := LmnnOptions()
+  p.Labels = alternatingLabels(40)
+  p.Optimizer = OptimizerLBFGS
+  p.Progress = func(iter int, objective float64) bool {
+    panic("boom")
+  }
+  _, _, _, err := LmnnWithError(randomMatrix(rng, 40, 3), p)
+  berr, ok := err.(*BindingError)
+  if !ok || berr.Param != "progress" {
+    t.Errorf("Lmnn() with a panicking progress function returned %v, want " +
+        "an error for progress", err)
+  }
+}
@@ -0,0 +1,544 @@
+// +build race,!nomlpack
+
+package mlpack
+
+import (
+  "bytes"
+  "fmt"
+  "io/ioutil"
+  "math/rand"
+  "os"
+  "path/filepath"
+  "sync"
+  "sync/atomic"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// The race detector sets the race build tag, so this suite only runs with
+// go test -race.  It calls every binding from several goroutines at once, so
+// that the detector sees the shared state of the package: the handles, the
+// models, the log routing and the call lock.
+
+// A binding call made by the suite.
+type raceCase struct {
+  name string
+  run func() error
+}
+
+// The inputs shared by all calls.  Bindings only read them.
+type raceData struct {
+  x *mat.Dense // 100 points with 3 dimensions in [0, 1).
+  labels *mat.Dense // Alternating labels 0 and 1 for x.
+  responses *mat.Dense // Responses for x.
+  ratings *mat.Dense // (user, item, rating) triples for cf.
+  numeric *matrixWithInfo // x, with every dimension numeric.
+  categorical *matrixWithInfo // A categorical and a numeric dimension.
+  sequence *mat.Dense // An observation sequence with 2 dimensions.
+  sequenceFile string // sequence, saved for hmm_train.
+  gmm *GMMModel
+  hmm *HMMModel
+  dir string
+  files int32
+}
+
+func newRaceData(t *testing.T, dir string) *raceData {
+  rng := rand.New(rand.NewSource(3))
+  d := &raceData{dir: dir}
+  d.x = randomMatrix(rng, 100, 3)
+  d.labels = mat.NewDense(100, 1, nil)
+  d.responses = mat.NewDense(100, 1, nil)
+  for i := 0; i < 100; i++ {
+    d.labels.Set(i, 0, float64(i % 2))
+    d.responses.Set(i, 0, 2 * d.x.At(i, 0) - d.x.At(i, 1) + rng.Float64() / 10)
+  }
+
+  var ratings []float64
+  for user := 0; user < 10; user++ {
+    for item := 0; item < 10; item++ {
+      if (user + item) % 3 != 0 {
+        ratings = append(ratings, float64(user), float64(item),
+            float64(1 + user * item % 5))
+      }
+    }
+  }
+  d.ratings = mat.NewDense(len(ratings) / 3, 3, ratings)
+
+  d.numeric = DataAndInfo()
+  d.numeric.Data = d.x
+  d.numeric.Categoricals = []bool{false, false, false}
+  categorical := mat.NewDense(100, 2, nil)
+  for i := 0; i < 100; i++ {
+    categorical.Set(i, 0, float64(i % 3))
+    categorical.Set(i, 1, d.x.At(i, 0))
+  }
+  d.categorical = DataAndInfo()
+  d.categorical.Data = categorical
+  d.categorical.Categoricals = []bool{true, false}
+
+  d.sequence = randomMatrix(rng, 50, 2)
+  d.sequenceFile = filepath.Join(dir, "sequence.csv")
+  if err := Save(d.sequenceFile, d.sequence); err != nil {
+    t.Fatal(err)
+  }
+
+  gmm, err := GmmTrainWithError(2, d.x, GmmTrainOptions())
+  if err != nil {
+    t.Fatal(err)
+  }
+  d.gmm = &gmm
+  hmmParam := HmmTrainOptions()
+  hmmParam.States = 2
+  hmmParam.Type = HMMGaussian
+  hmm, err := HmmTrainWithError(d.sequenceFile, hmmParam)
+  if err != nil {
+    t.Fatal(err)
+  }
+  d.hmm = &hmm
+  return d
+}
+
+func (d *raceData) close() {
+  d.gmm.Close()
+  d.hmm.Close()
+}
+
+// Returns a new file name in the temporary directory.
+func (d *raceData) file(ext string) string {
+  n := atomic.AddInt32(&d.files, 1)
+  return filepath.Join(d.dir, fmt.Sprintf("file%d.%s", n, ext))
+}
+
+func raceCases(d *raceData) []raceCase {
+  return []raceCase{
+    {"adaboost", func() error {
+      p := AdaboostOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      m, _, _, err := AdaboostWithError(p)
+      m.Close()
+      return err
+    }},
+    {"approx_kfn", func() error {
+      p := ApproxKfnOptions()
+      p.Reference = d.x
+      p.K = 2
+      _, _, m, err := ApproxKfnWithError(p)
+      m.Close()
+      return err
+    }},
+    {"bayesian_linear_regression", func() error {
+      p := BayesianLinearRegressionOptions()
+      p.Input = d.x
+      p.Responses = d.responses
+      m, _, _, err := BayesianLinearRegressionWithError(p)
+      m.Close()
+      return err
+    }},
+    {"cf", func() error {
+      p := CfOptions()
+      p.Training = d.ratings
+      _, m, err := CfWithError(p)
+      m.Close()
+      return err
+    }},
+    {"dbscan", func() error {
+      _, _, err := DbscanWithError(d.x, DbscanOptions())
+      return err
+    }},
+    {"decision_tree", func() error {
+      p := DecisionTreeOptions()
+      p.Training = d.numeric
+      p.Labels = d.labels
+      m, _, _, err := DecisionTreeWithError(p)
+      m.Close()
+      return err
+    }},
+    {"det", func() error {
+      p := DetOptions()
+      p.Training = d.x
+      m, _, _, _, _, _, err := DetWithError(p)
+      m.Close()
+      return err
+    }},
+    {"emst", func() error {
+      _, err := EmstWithError(d.x, EmstOptions())
+      return err
+    }},
+    {"fastmks", func() error {
+      p := FastmksOptions()
+      p.Reference = d.x
+      p.K = 2
+      _, _, m, err := FastmksWithError(p)
+      m.Close()
+      return err
+    }},
+    {"gmm_generate", func() error {
+      _, err := GmmGenerateWithError(d.gmm, 10, GmmGenerateOptions())
+      return err
+    }},
+    {"gmm_probability", func() error {
+      _, err := GmmProbabilityWithError(d.x, d.gmm, GmmProbabilityOptions())
+      return err
+    }},
+    {"gmm_train", func() error {
+      m, err := GmmTrainWithError(2, d.x, GmmTrainOptions())
+      m.Close()
+      return err
+    }},
+    {"hmm_generate", func() error {
+      _, _, err := HmmGenerateWithError(10, d.hmm, HmmGenerateOptions())
+      return err
+    }},
+    {"hmm_loglik", func() error {
+      _, err := HmmLoglikWithError(d.sequence, d.hmm, HmmLoglikOptions())
+      return err
+    }},
+    {"hmm_train", func() error {
+      p := HmmTrainOptions()
+      p.States = 2
+      p.Type = HMMGaussian
+      m, err := HmmTrainWithError(d.sequenceFile, p)
+      m.Close()
+      return err
+    }},
+    {"hmm_viterbi", func() error {
+      _, err := HmmViterbiWithError(d.sequence, d.hmm, HmmViterbiOptions())
+      return err
+    }},
+    {"hoeffding_tree", func() error {
+      p := HoeffdingTreeOptions()
+      p.Training = d.numeric
+      p.Labels = d.labels
+      m, _, _, err := HoeffdingTreeWithError(p)
+      m.Close()
+      return err
+    }},
+    {"image_converter", func() error {
+      p := ImageConverterOptions()
+      p.Save = true
+      p.Dataset = mat.NewDense(1, 4 * 4 * 3, nil)
+      p.Width = 4
+      p.Height = 4
+      p.Channels = 3
+      _, err := ImageConverterWithError([]string{d.file("png")}, p)
+      return err
+    }},
+    {"kde", func() error {
+      p := KdeOptions()
+      p.Reference = d.x
+      p.Query = d.x
+      m, _, err := KdeWithError(p)
+      m.Close()
+      return err
+    }},
+    {"kernel_pca", func() error {
+      _, err := KernelPcaWithError(d.x, KernelLinear, KernelPcaOptions())
+      return err
+    }},
+    {"kfn", func() error {
+      p := KfnOptions()
+      p.Reference = d.x
+      p.K = 2
+      _, _, m, err := KfnWithError(p)
+      m.Close()
+      return err
+    }},
+    {"kmeans", func() error {
+      _, _, err := KmeansWithError(3, d.x, KmeansOptions())
+      return err
+    }},
+    {"knn", func() error {
+      p := KnnOptions()
+      p.Reference = d.x
+      p.K = 2
+      _, _, m, err := KnnWithError(p)
+      m.Close()
+      return err
+    }},
+    {"krann", func() error {
+      p := KrannOptions()
+      p.Reference = d.x
+      p.K = 2
+      _, _, m, err := KrannWithError(p)
+      m.Close()
+      return err
+    }},
+    {"lars", func() error {
+      p := LarsOptions()
+      p.Input = d.x
+      p.Responses = d.responses
+      m, _, err := LarsWithError(p)
+      m.Close()
+      return err
+    }},
+    {"linear_regression", func() error {
+      p := LinearRegressionOptions()
+      p.Training = d.x
+      p.TrainingResponses = d.responses
+      m, _, err := LinearRegressionWithError(p)
+      m.Close()
+      return err
+    }},
+    {"linear_svm", func() error {
+      p := LinearSvmOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      m, _, _, err := LinearSvmWithError(p)
+      m.Close()
+      return err
+    }},
+    {"lmnn", func() error {
+      p := LmnnOptions()
+      p.Labels = d.labels
+      p.MaxIterations = 10
+      _, _, _, err := LmnnWithError(d.x, p)
+      return err
+    }},
+    {"local_coordinate_coding", func() error {
+      p := LocalCoordinateCodingOptions()
+      p.Training = d.x
+      p.Atoms = 3
+      p.MaxIterations = 2
+      _, _, m, err := LocalCoordinateCodingWithError(p)
+      m.Close()
+      return err
+    }},
+    {"logistic_regression", func() error {
+      p := LogisticRegressionOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      m, _, _, err := LogisticRegressionWithError(p)
+      m.Close()
+      return err
+    }},
+    {"lsh", func() error {
+      p := LshOptions()
+      p.Reference = d.x
+      p.K = 2
+      _, _, m, err := LshWithError(p)
+      m.Close()
+      return err
+    }},
+    {"mean_shift", func() error {
+      _, _, err := MeanShiftWithError(d.x, MeanShiftOptions())
+      return err
+    }},
+    {"nbc", func() error {
+      p := NbcOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      m, _, _, err := NbcWithError(p)
+      m.Close()
+      return err
+    }},
+    {"nca", func() error {
+      p := NcaOptions()
+      p.Labels = d.labels
+      p.MaxIterations = 10
+      _, err := NcaWithError(d.x, p)
+      return err
+    }},
+    {"nmf", func() error {
+      p := NmfOptions()
+      p.MaxIterations = 10
+      _, _, err := NmfWithError(d.x, 2, p)
+      return err
+    }},
+    {"pca", func() error {
+      p := PcaOptions()
+      p.NewDimensionality = 2
+      _, err := PcaWithError(d.x, p)
+      return err
+    }},
+    {"perceptron", func() error {
+      p := PerceptronOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      m, _, err := PerceptronWithError(p)
+      m.Close()
+      return err
+    }},
+    {"preprocess_binarize", func() error {
+      p := PreprocessBinarizeOptions()
+      p.Threshold = 0.5
+      _, err := PreprocessBinarizeWithError(d.x, p)
+      return err
+    }},
+    {"preprocess_describe", func() error {
+      return PreprocessDescribeWithError(d.x, PreprocessDescribeOptions())
+    }},
+    {"preprocess_one_hot_encoding", func() error {
+      _, err := PreprocessOneHotEncodingWithError(d.categorical,
+          PreprocessOneHotEncodingOptions())
+      return err
+    }},
+    {"preprocess_scale", func() error {
+      _, m, err := PreprocessScaleWithError(d.x, PreprocessScaleOptions())
+      m.Close()
+      return err
+    }},
+    {"preprocess_split", func() error {
+      p := PreprocessSplitOptions()
+      p.InputLabels = d.labels
+      _, _, _, _, err := PreprocessSplitWithError(d.x, p)
+      return err
+    }},
+    {"radical", func() error {
+      p := RadicalOptions()
+      p.Replicates = 5
+      _, _, err := RadicalWithError(d.x, p)
+      return err
+    }},
+    {"random_forest", func() error {
+      p := RandomForestOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      p.NumTrees = 5
+      m, _, _, err := RandomForestWithError(p)
+      m.Close()
+      return err
+    }},
+    {"softmax_regression", func() error {
+      p := SoftmaxRegressionOptions()
+      p.Training = d.x
+      p.Labels = d.labels
+      p.MaxIterations = 10
+      m, _, _, err := SoftmaxRegressionWithError(p)
+      m.Close()
+      return err
+    }},
+    {"sparse_coding", func() error {
+      p := SparseCodingOptions()
+      p.Training = d.x
+      p.Atoms = 3
+      p.MaxIterations = 2
+      _, _, m, err := SparseCodingWithError(p)
+      m.Close()
+      return err
+    }},
+  }
+}
+
+// Every binding must be covered, so that a new one is added to the suite.
+func TestRaceCasesCoverBindings(t *testing.T) {
+  covered := make(map[string]bool)
+  for _, c := range raceCases(&raceData{}) {
+    covered[c.name] = true
+  }
+  for _, name := range Bindings() {
+    if !covered[name] {
+      t.Errorf("binding %s is not called by the race suite", name)
+    }
+  }
+}
+
+// Runs every binding twice, all calls at once.
+func TestBindingsConcurrently(t *testing.T) {
+  if testing.Short() {
+    t.Skip("skipping the concurrent binding calls in short mode")
+  }
+  dir, err := ioutil.TempDir("", "mlpack-race")
+  if err != nil {
+    t.Fatal(err)
+  }
+  defer os.RemoveAll(dir)
+  d := newRaceData(t, dir)
+  defer d.close()
+
+  var wg sync.WaitGroup
+  for round := 0; round < 2; round++ {
+    for _, c := range raceCases(d) {
+      wg.Add(1)
+      go func(c raceCase) {
+        defer wg.Done()
+        if err := c.run(); err != nil {
+          t.Errorf("%s: %v", c.name, err)
+        }
+      }(c)
+    }
+  }
+  wg.Wait()
+}
+
+// A writer that may be shared by several log outputs.
+type lockedBuffer struct {
+  mu sync.Mutex
+  buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+  b.mu.Lock()
+  defer b.mu.Unlock()
+  return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) Len() int {
+  b.mu.Lock()
+  defer b.mu.Unlock()
+  return b.buf.Len()
+}
+
+// Runs plain calls alongside calls with Verbose, calls with a per-call Log,
+// and SetLogOutput(), which all change the log routing of the process.
+func TestLoggingConcurrently(t *testing.T) {
+  if testing.Short() {
+    t.Skip("skipping the concurrent logging calls in short mode")
+  }
+  defer SetLogOutput(nil, nil)
+  reference := randomMatrix(rand.New(rand.NewSource(4)), 200, 3)
+  knn := func(verbose bool, out *LogOutput) error {
+    p := KnnOptions()
+    p.Reference = reference
+    p.K = 2
+    p.Verbose = verbose
+    p.Log = out
+    _, _, m, err := KnnWithError(p)
+    m.Close()
+    return err
+  }
+
+  var global lockedBuffer
+  logs := make([]*lockedBuffer, 8)
+  var wg sync.WaitGroup
+  for i := range logs {
+    logs[i] = &lockedBuffer{}
+    wg.Add(4)
+    go func() {
+      defer wg.Done()
+      if err := knn(false, nil); err != nil {
+        t.Errorf("plain call: %v", err)
+      }
+    }()
+    go func() {
+      defer wg.Done()
+      if err := knn(true, nil); err != nil {
+        t.Errorf("verbose call: %v", err)
+      }
+    }()
+    go func(out *lockedBuffer) {
+      defer wg.Done()
+      if err := knn(true, &LogOutput{Info: out, Warn: out}); err != nil {
+        t.Errorf("call with Log: %v", err)
+      }
+    }(logs[i])
+    go func(i int) {
+      defer wg.Done()
+      if i % 2 == 0 {
+        SetLogOutput(&global, &global)
+      } else {
+        SetLogOutput(nil, nil)
+      }
+    }(i)
+  }
+  wg.Wait()
+
+  // Whatever the routing set by SetLogOutput(), a call with Log and Verbose
+  // set gets its informational messages.
+  for i, out := range logs {
+    if out.Len() == 0 {
+      t.Errorf("call %d with Log and Verbose set logged nothing", i)
+    }
+  }
+}
@@ -0,0 +1,265 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "fmt"
+  "reflect"
+  "sort"
+)
+
+// The functions that call each mlpack program, by binding name.  Every binding
+// file registers its program from init().
+var bindingRunners = make(map[string]func(p *params, t *timers))
+
+// Registers the function that calls the mlpack program of a binding.
+func registerBinding(name string, run func(p *params, t *timers)) {
+  bindingRunners[name] = run
+}
+
+/*
+  Run calls the binding with the given mlpack name, e.g. "knn", with
+  parameters given by their mlpack names, e.g. "leaf_size", and returns all of
+  its outputs, again by their mlpack names.  It is meant for programs that
+  decide which binding to call at runtime, for instance from a configuration
+  file; DescribeBinding() lists the parameters a binding accepts.
+
+  Each input is converted according to the type mlpack reports for it:
+
+   - int: any integer, or a float with an integral value.
+   - double, float: any integer or float.
+   - bool: a bool.
+   - std::string: any string type, including the enum types of this package.
+   - std::vector<int>, std::vector<std::string>: a slice whose elements
+     convert as above, e.g. []int or []interface{}.
+   - Matrices: a mat.Matrix, or a slice of rows, each a slice of numbers, e.g.
+     [][]float64 or [][]interface{}.  Row and column vectors also accept a
+     single slice of numbers.
+   - Matrices with dataset info: a value created with DataAndInfo(), or a
+     matrix as above, which is taken to have only numeric dimensions.
+   - Models: the model type returned by the bindings, e.g. KNNModel or
+     *KNNModel.
+
+  Matrix outputs are returned as *mat.Dense, models as the model types of this
+  package, and all other outputs with the Go type used by the bindings.  The
+  "verbose" parameter enables mlpack's informational output, as for the
+  bindings; Run() has no equivalent of the Log, Threads and Progress options,
+  and uses the limit set with SetNumThreads().
+
+  Errors in the given parameters, such as unknown names, values of the wrong
+  type or missing required inputs, are reported as a *BindingError before
+  mlpack is called.
+*/
+func Run(binding string, in map[string]interface{}) (map[string]interface{}, error) {
+  run, ok := bindingRunners[binding]
+  if !ok {
+    return nil, fmt.Errorf("mlpack: unknown binding %q", binding)
+  }
+
+  params := getParams(binding)
+  timers := getTimers()
+
+  verbose, _ := in["verbose"].(bool)
+  call := beginCall(verbose, nil)
+  defer call.end()
+  setNumThreads(params, 0)
+
+  infos := make(map[string]paramInfo)
+  for _, info := range readParams(params) {
+    infos[info.Name] = info
+  }
+
+  // Report unknown parameters in a fixed order.
+  names := make([]string, 0, len(in))
+  for name := range in {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+  for _, name := range names {
+    if info, ok := infos[name]; !ok {
+      setError(params, name, "unknown parameter")
+    } else if !info.Input {
+      setError(params, name, "output parameters cannot be given")
+    }
+  }
+
+  for _, info := range readParams(params) {
+    if !info.Input {
+      // Mark all output options as passed.
+      setPassed(params, info.Name)
+      continue
+    }
+    value, ok := in[info.Name]
+    if !ok {
+      if info.Required {
+        setError(params, info.Name, "required parameter not given")
+      }
+      continue
+    }
+    setRunParam(params, info, value)
+    setPassed(params, info.Name)
+  }
+
+  // Call the mlpack program.
+  if params.err == nil {
+    run(params, timers)
+  }
+  if err := getError(params); err != nil {
+    // Clean memory.
+    cleanParams(params)
+    cleanTimers(timers)
+    return nil, err
+  }
+
+  out := make(map[string]interface{})
+  for _, info := range readParams(params) {
+    if !info.Input {
+      out[info.Name] = getRunParam(params, info)
+    }
+  }
+  // Clean memory.
+  cleanParams(params)
+  cleanTimers(timers)
+  return out, nil
+}
+
+// Converts a value given to Run() and passes it to mlpack.  Values that cannot
+// be converted to the type of the parameter are reported with setError().
+func setRunParam(p *params, info paramInfo, value interface{}) {
+  if v := reflect.ValueOf(value); !v.IsValid() ||
+      (v.Kind() == reflect.Ptr && v.IsNil()) {
+    setError(p, info.Name, "value is nil")
+    return
+  }
+
+  switch info.Type {
+  case "int":
+    if v, ok := intValue(value); ok {
+      setParamInt(p, info.Name, v)
+      return
+    }
+  case "double":
+    if v, ok := floatValue(value); ok {
+      setParamDouble(p, info.Name, v)
+      return
+    }
+  case "float":
+    if v, ok := floatValue(value); ok {
+      setParamFloat(p, info.Name, v)
+      return
+    }
+  case "bool":
+    if v, ok := value.(bool); ok {
+      setParamBool(p, info.Name, v)
+      return
+    }
+  case "std::string":
+    if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+      setParamString(p, info.Name, v.String())
+      return
+    }
+  case "std::vector<int>":
+    if v, ok := intsValue(value); ok {
+      setParamVecInt(p, info.Name, v)
+      return
+    }
+  case "std::vector<std::string>":
+    if v, ok := stringsValue(value); ok {
+      setParamVecString(p, info.Name, v)
+      return
+    }
+  case "arma::mat":
+    if m, ok := matrixValue(value, false); ok {
+      gonumToArmaMat(p, info.Name, m, info.noTranspose)
+      return
+    }
+  case "arma::Mat<size_t>", "arma::umat":
+    if m, ok := matrixValue(value, false); ok {
+      gonumToArmaUmat(p, info.Name, m)
+      return
+    }
+  case "arma::rowvec":
+    if m, ok := matrixValue(value, true); ok {
+      gonumToArmaRow(p, info.Name, m)
+      return
+    }
+  case "arma::Row<size_t>", "arma::urowvec":
+    if m, ok := matrixValue(value, true); ok {
+      gonumToArmaUrow(p, info.Name, m)
+      return
+    }
+  case "arma::vec", "arma::colvec":
+    if m, ok := matrixValue(value, true); ok {
+      gonumToArmaCol(p, info.Name, m)
+      return
+    }
+  case "arma::Col<size_t>", "arma::ucolvec":
+    if m, ok := matrixValue(value, true); ok {
+      gonumToArmaUcol(p, info.Name, m)
+      return
+    }
+  case "std::tuple<mlpack::data::DatasetInfo, arma::mat>":
+    if m, ok := value.(*matrixWithInfo); ok {
+      gonumToArmaMatWithInfo(p, info.Name, m)
+      return
+    }
+    if m, ok := matrixValue(value, false); ok {
+      _, c := m.Dims()
+      gonumToArmaMatWithInfo(p, info.Name,
+          &matrixWithInfo{Categoricals: make([]bool, c), Data: m})
+      return
+    }
+  default:
+    name, ok := modelTypeName(info.Type)
+    if !ok {
+      setError(p, info.Name, "unsupported parameter type " + info.Type)
+      return
+    }
+    if model, ok := modelParams[name]; ok && model.set(p, info.Name, value) {
+      return
+    }
+  }
+  setError(p, info.Name,
+      fmt.Sprintf("cannot use a value of type %T as %s", value, info.Type))
+}
+
+// Reads an output of a binding called by Run().
+func getRunParam(p *params, info paramInfo) interface{} {
+  var arma mlpackArma
+  switch info.Type {
+  case "int":
+    return getParamInt(p, info.Name)
+  case "double":
+    return getParamDouble(p, info.Name)
+  case "float":
+    return getParamFloat(p, info.Name)
+  case "bool":
+    return getParamBool(p, info.Name)
+  case "std::string":
+    return getParamString(p, info.Name)
+  case "std::vector<int>":
+    return getParamVecInt(p, info.Name)
+  case "std::vector<std::string>":
+    return getParamVecString(p, info.Name)
+  case "arma::mat":
+    return arma.armaToGonumMat(p, info.Name)
+  case "arma::Mat<size_t>", "arma::umat":
+    return arma.armaToGonumUmat(p, info.Name)
+  case "arma::rowvec":
+    return arma.armaToGonumRow(p, info.Name)
+  case "arma::Row<size_t>", "arma::urowvec":
+    return arma.armaToGonumUrow(p, info.Name)
+  case "arma::vec", "arma::colvec":
+    return arma.armaToGonumCol(p, info.Name)
+  case "arma::Col<size_t>", "arma::ucolvec":
+    return arma.armaToGonumUcol(p, info.Name)
+  case "std::tuple<mlpack::data::DatasetInfo, arma::mat>":
+    return arma.armaToGonumMatWithInfo(p, info.Name)
+  }
+  if name, ok := modelTypeName(info.Type); ok {
+    if model, ok := modelParams[name]; ok {
+      return model.get(p, info.Name)
+    }
+  }
+  return nil
+}
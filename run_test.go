@@ -0,0 +1,117 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "math/rand"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Run() must give the same results as the binding function.
+func TestRunKnn(t *testing.T) {
+  rng := rand.New(rand.NewSource(1))
+  reference := randomMatrix(rng, 30, 3)
+  query := randomMatrix(rng, 5, 3)
+
+  param := KnnOptions()
+  param.Reference = reference
+  param.Query = query
+  param.K = 2
+  wantDistances, wantNeighbors, model, err := KnnWithError(param)
+  if err != nil {
+    t.Fatalf("Knn() failed: %v", err)
+  }
+  model.Close()
+
+  // Rows given as [][]interface{} and an integral float as the int k.
+  rows := make([][]interface{}, 5)
+  for i := range rows {
+    rows[i] = []interface{}{query.At(i, 0), query.At(i, 1), query.At(i, 2)}
+  }
+  out, err := Run("knn", map[string]interface{}{
+    "reference": reference,
+    "query": rows,
+    "k": 2.0,
+  })
+  if err != nil {
+    t.Fatalf("Run(\"knn\") failed: %v", err)
+  }
+  distances, ok := out["distances"].(*mat.Dense)
+  if !ok || !mat.Equal(distances, wantDistances) {
+    t.Errorf("Run(\"knn\") distances = %v, want %v", out["distances"],
+        mat.Formatted(wantDistances))
+  }
+  neighbors, ok := out["neighbors"].(*mat.Dense)
+  if !ok || !mat.Equal(neighbors, wantNeighbors) {
+    t.Errorf("Run(\"knn\") neighbors = %v, want %v", out["neighbors"],
+        mat.Formatted(wantNeighbors))
+  }
+  output, ok := out["output_model"].(KNNModel)
+  if !ok {
+    t.Fatalf("Run(\"knn\") output_model is a %T, want a KNNModel",
+        out["output_model"])
+  }
+  output.Close()
+}
+
+func TestRunUnknownBinding(t *testing.T) {
+  if _, err := Run("no_such_binding", nil); err == nil {
+    t.Error("Run() with an unknown binding did not fail")
+  }
+}
+
+// Errors in the inputs must be reported for the parameter they refer to.
+func TestRunErrors(t *testing.T) {
+  reference := randomMatrix(rand.New(rand.NewSource(2)), 10, 2)
+  cases := []struct {
+    name string
+    in map[string]interface{}
+    param string
+  }{
+    {"unknown parameter",
+        map[string]interface{}{"reference": reference, "kay": 1}, "kay"},
+    {"output parameter",
+        map[string]interface{}{"reference": reference, "distances": reference},
+        "distances"},
+    {"nil value",
+        map[string]interface{}{"reference": reference, "k": nil}, "k"},
+    {"nil matrix",
+        map[string]interface{}{"reference": (*mat.Dense)(nil), "k": 1},
+        "reference"},
+    {"non-integral int",
+        map[string]interface{}{"reference": reference, "k": 1.5}, "k"},
+    {"wrong type",
+        map[string]interface{}{"reference": reference, "k": "1"}, "k"},
+    {"ragged matrix",
+        map[string]interface{}{"reference": [][]interface{}{{1, 2}, {3}},
+            "k": 1}, "reference"},
+    {"string as bool",
+        map[string]interface{}{"reference": reference, "naive": "true"},
+        "naive"},
+    {"number as string",
+        map[string]interface{}{"reference": reference, "algorithm": 1},
+        "algorithm"},
+    {"wrong model type",
+        map[string]interface{}{"input_model": reference}, "input_model"},
+  }
+  for _, c := range cases {
+    out, err := Run("knn", c.in)
+    berr, ok := err.(*BindingError)
+    if !ok {
+      t.Errorf("%s: Run() = %v, %v, want a *BindingError", c.name, out, err)
+      continue
+    }
+    if berr.Binding != "knn" || berr.Param != c.param {
+      t.Errorf("%s: the error is for %s/%s, want knn/%s", c.name,
+          berr.Binding, berr.Param, c.param)
+    }
+  }
+
+  _, err := Run("pca", map[string]interface{}{"new_dimensionality": 1})
+  if berr, ok := err.(*BindingError); !ok || berr.Param != "input" {
+    t.Errorf("Run(\"pca\") without its required input = %v, want a " +
+        "*BindingError for input", err)
+  }
+}
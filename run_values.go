@@ -0,0 +1,147 @@
+package mlpack
+
+import (
+  "math"
+  "reflect"
+  "strings"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Converts any integer, or a float with an integral value in the range of an
+// int64, to an int.
+func intValue(value interface{}) (int, bool) {
+  v := reflect.ValueOf(value)
+  switch v.Kind() {
+  case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+    return int(v.Int()), true
+  case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+       reflect.Uint64:
+    return int(v.Uint()), true
+  case reflect.Float32, reflect.Float64:
+    if f := v.Float(); f == math.Trunc(f) && f >= math.MinInt64 &&
+        f < math.MaxInt64 {
+      return int(f), true
+    }
+  }
+  return 0, false
+}
+
+// Converts any integer or float to a float64.
+func floatValue(value interface{}) (float64, bool) {
+  v := reflect.ValueOf(value)
+  switch v.Kind() {
+  case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+    return float64(v.Int()), true
+  case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+       reflect.Uint64:
+    return float64(v.Uint()), true
+  case reflect.Float32, reflect.Float64:
+    return v.Float(), true
+  }
+  return 0, false
+}
+
+// Returns the elements of a slice or array, or false if value is neither.
+func sliceElements(value interface{}) ([]interface{}, bool) {
+  v := reflect.ValueOf(value)
+  if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+    return nil, false
+  }
+  elems := make([]interface{}, v.Len())
+  for i := range elems {
+    elems[i] = v.Index(i).Interface()
+  }
+  return elems, true
+}
+
+// Converts a slice of integers to an []int.
+func intsValue(value interface{}) ([]int, bool) {
+  elems, ok := sliceElements(value)
+  if !ok {
+    return nil, false
+  }
+  ints := make([]int, len(elems))
+  for i, elem := range elems {
+    if ints[i], ok = intValue(elem); !ok {
+      return nil, false
+    }
+  }
+  return ints, true
+}
+
+// Converts a slice of strings to a []string.
+func stringsValue(value interface{}) ([]string, bool) {
+  elems, ok := sliceElements(value)
+  if !ok {
+    return nil, false
+  }
+  strs := make([]string, len(elems))
+  for i, elem := range elems {
+    v := reflect.ValueOf(elem)
+    if v.Kind() != reflect.String {
+      return nil, false
+    }
+    strs[i] = v.String()
+  }
+  return strs, true
+}
+
+// Converts a slice of numbers to a []float64.
+func floatsValue(value interface{}) ([]float64, bool) {
+  elems, ok := sliceElements(value)
+  if !ok {
+    return nil, false
+  }
+  floats := make([]float64, len(elems))
+  for i, elem := range elems {
+    if floats[i], ok = floatValue(elem); !ok {
+      return nil, false
+    }
+  }
+  return floats, true
+}
+
+// Converts a mat.Matrix or a slice of rows to a matrix.  If vector is true, a
+// single slice of numbers is also accepted, as a column vector.
+func matrixValue(value interface{}, vector bool) (mat.Matrix, bool) {
+  if m, ok := value.(mat.Matrix); ok {
+    return m, true
+  }
+  if vector {
+    if data, ok := floatsValue(value); ok && len(data) > 0 {
+      return mat.NewVecDense(len(data), data), true
+    }
+  }
+  rows, ok := sliceElements(value)
+  if !ok || len(rows) == 0 {
+    return nil, false
+  }
+  var data []float64
+  cols := 0
+  for i, row := range rows {
+    r, ok := floatsValue(row)
+    if !ok || len(r) == 0 || (i > 0 && len(r) != cols) {
+      return nil, false
+    }
+    cols = len(r)
+    data = append(data, r...)
+  }
+  return mat.NewDense(len(rows), cols, data), true
+}
+
+// Returns the name of the model type for a C++ type such as
+// "mlpack::KNNModel*", or false if the type is not a model.
+func modelTypeName(cppType string) (string, bool) {
+  if !strings.HasSuffix(cppType, "*") {
+    return "", false
+  }
+  name := strings.TrimSpace(strings.TrimSuffix(cppType, "*"))
+  if i := strings.Index(name, "<"); i >= 0 {
+    name = name[:i]
+  }
+  if i := strings.LastIndex(name, "::"); i >= 0 {
+    name = name[i+2:]
+  }
+  return name, true
+}
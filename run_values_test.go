@@ -0,0 +1,197 @@
+package mlpack
+
+import (
+  "math"
+  "reflect"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+type level string
+
+func TestIntValue(t *testing.T) {
+  cases := []struct {
+    value interface{}
+    want int
+    ok bool
+  }{
+    {3, 3, true},
+    {int8(-4), -4, true},
+    {uint64(5), 5, true},
+    {float32(6), 6, true},
+    {7.0, 7, true},
+    {-2.0, -2, true},
+    {1.5, 0, false},
+    {float32(0.25), 0, false},
+    {math.NaN(), 0, false},
+    {math.Inf(1), 0, false},
+    {1e300, 0, false},
+    {"3", 0, false},
+    {true, 0, false},
+    {nil, 0, false},
+  }
+  for _, c := range cases {
+    got, ok := intValue(c.value)
+    if got != c.want || ok != c.ok {
+      t.Errorf("intValue(%#v) = %d, %v, want %d, %v", c.value, got, ok,
+          c.want, c.ok)
+    }
+  }
+}
+
+func TestFloatValue(t *testing.T) {
+  cases := []struct {
+    value interface{}
+    want float64
+    ok bool
+  }{
+    {3, 3, true},
+    {uint8(200), 200, true},
+    {float32(0.5), 0.5, true},
+    {1.25, 1.25, true},
+    {"1.25", 0, false},
+    {false, 0, false},
+    {nil, 0, false},
+  }
+  for _, c := range cases {
+    got, ok := floatValue(c.value)
+    if got != c.want || ok != c.ok {
+      t.Errorf("floatValue(%#v) = %v, %v, want %v, %v", c.value, got, ok,
+          c.want, c.ok)
+    }
+  }
+}
+
+func TestIntsValue(t *testing.T) {
+  cases := []struct {
+    value interface{}
+    want []int
+    ok bool
+  }{
+    {[]int{1, 2}, []int{1, 2}, true},
+    {[2]int64{3, 4}, []int{3, 4}, true},
+    {[]interface{}{1, uint(2), 3.0}, []int{1, 2, 3}, true},
+    {[]int{}, []int{}, true},
+    {[]interface{}{1, 2.5}, nil, false},
+    {[]interface{}{1, nil}, nil, false},
+    {[]string{"1"}, nil, false},
+    {1, nil, false},
+    {nil, nil, false},
+  }
+  for _, c := range cases {
+    got, ok := intsValue(c.value)
+    if !reflect.DeepEqual(got, c.want) || ok != c.ok {
+      t.Errorf("intsValue(%#v) = %v, %v, want %v, %v", c.value, got, ok,
+          c.want, c.ok)
+    }
+  }
+}
+
+func TestStringsValue(t *testing.T) {
+  cases := []struct {
+    value interface{}
+    want []string
+    ok bool
+  }{
+    {[]string{"a", "b"}, []string{"a", "b"}, true},
+    {[]level{"info"}, []string{"info"}, true},
+    {[]interface{}{"a", level("b")}, []string{"a", "b"}, true},
+    {[]interface{}{"a", 1}, nil, false},
+    {[]interface{}{"a", nil}, nil, false},
+    {"a", nil, false},
+    {nil, nil, false},
+  }
+  for _, c := range cases {
+    got, ok := stringsValue(c.value)
+    if !reflect.DeepEqual(got, c.want) || ok != c.ok {
+      t.Errorf("stringsValue(%#v) = %v, %v, want %v, %v", c.value, got, ok,
+          c.want, c.ok)
+    }
+  }
+}
+
+func TestFloatsValue(t *testing.T) {
+  got, ok := floatsValue([]interface{}{1, float32(0.5), uint(2)})
+  if want := []float64{1, 0.5, 2}; !ok || !reflect.DeepEqual(got, want) {
+    t.Errorf("floatsValue() = %v, %v, want %v, true", got, ok, want)
+  }
+  if got, ok := floatsValue([]interface{}{1, "2"}); ok {
+    t.Errorf("floatsValue() with a string element = %v, want false", got)
+  }
+}
+
+func TestMatrixValue(t *testing.T) {
+  dense := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+  cases := []struct {
+    name string
+    value interface{}
+    vector bool
+    want mat.Matrix
+  }{
+    {"mat.Matrix", dense, false, dense},
+    {"[][]float64", [][]float64{{1, 2}, {3, 4}}, false, dense},
+    {"[][]interface{}", [][]interface{}{{1, 2.0}, {uint(3), float32(4)}},
+        false, dense},
+    {"[]interface{} of rows", []interface{}{[]int{1, 2}, []float64{3, 4}},
+        false, dense},
+    {"vector", []float64{1, 2, 3}, true,
+        mat.NewVecDense(3, []float64{1, 2, 3})},
+    {"[]interface{} vector", []interface{}{1, 2.5}, true,
+        mat.NewVecDense(2, []float64{1, 2.5})},
+    {"vector of one row", [][]float64{{1, 2, 3}}, true,
+        mat.NewDense(1, 3, []float64{1, 2, 3})},
+    {"vector as matrix", []float64{1, 2, 3}, false, nil},
+    {"ragged [][]float64", [][]float64{{1, 2}, {3}}, false, nil},
+    {"ragged [][]interface{}", [][]interface{}{{1, 2}, {3, 4, 5}}, false,
+        nil},
+    {"non-numeric element", [][]interface{}{{1, "2"}, {3, 4}}, false, nil},
+    {"nil element", [][]interface{}{{1, nil}, {3, 4}}, false, nil},
+    {"nil row", [][]interface{}{{1, 2}, nil}, false, nil},
+    {"empty row", [][]float64{{}}, false, nil},
+    {"no rows", [][]float64{}, false, nil},
+    {"empty vector", []float64{}, true, nil},
+    {"nil", nil, false, nil},
+    {"nil vector", nil, true, nil},
+    {"string", "1 2", false, nil},
+  }
+  for _, c := range cases {
+    got, ok := matrixValue(c.value, c.vector)
+    if c.want == nil {
+      if ok {
+        t.Errorf("%s: matrixValue() = %v, want false", c.name, got)
+      }
+      continue
+    }
+    if !ok {
+      t.Errorf("%s: matrixValue() failed", c.name)
+      continue
+    }
+    if !mat.Equal(got, c.want) {
+      t.Errorf("%s: matrixValue() = %v, want %v", c.name,
+          mat.Formatted(got), mat.Formatted(c.want))
+    }
+  }
+}
+
+func TestModelTypeName(t *testing.T) {
+  cases := []struct {
+    cppType string
+    want string
+    ok bool
+  }{
+    {"KNNModel*", "KNNModel", true},
+    {"mlpack::KNNModel*", "KNNModel", true},
+    {"mlpack::RANNModel *", "RANNModel", true},
+    {"mlpack::HMMModel<double>*", "HMMModel", true},
+    {"mlpack::KNNModel", "", false},
+    {"arma::mat", "", false},
+  }
+  for _, c := range cases {
+    got, ok := modelTypeName(c.cppType)
+    if got != c.want || ok != c.ok {
+      t.Errorf("modelTypeName(%q) = %q, %v, want %q, %v", c.cppType, got, ok,
+          c.want, c.ok)
+    }
+  }
+}
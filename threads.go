@@ -0,0 +1,45 @@
+package mlpack
+
+import (
+  "strconv"
+  "sync/atomic"
+)
+
+// The thread count set with SetNumThreads(), or 0 for the OpenMP default.
+var numThreads int32
+
+// SetNumThreads limits the number of OpenMP threads that each binding call may
+// use, for instance in random forest training, k-means or neighbor search.
+// Calls running in parallel each get up to n threads.  A value of 0 or less
+// restores the OpenMP default, which is normally the number of CPUs or the
+// value of OMP_NUM_THREADS.  The Threads option of a binding overrides this
+// for a single call.
+func SetNumThreads(n int) {
+  if n < 0 {
+    n = 0
+  }
+  atomic.StoreInt32(&numThreads, int32(n))
+}
+
+// NumThreads returns the number of OpenMP threads that a binding call uses when
+// its Threads option is not set.
+func NumThreads() int {
+  if n := atomic.LoadInt32(&numThreads); n > 0 {
+    return int(n)
+  }
+  return defaultNumThreads()
+}
+
+// Returns the thread count for a call of the given binding with the given
+// Threads option: threads if it is positive, otherwise the value set with
+// SetNumThreads(), where 0 means the OpenMP default.
+func callThreads(binding string, threads int) (int, *BindingError) {
+  if threads < 0 {
+    return 0, &BindingError{Binding: binding, Param: "threads",
+        Message: "must not be negative, got " + strconv.Itoa(threads)}
+  }
+  if threads == 0 {
+    threads = int(atomic.LoadInt32(&numThreads))
+  }
+  return threads, nil
+}
@@ -0,0 +1,70 @@
+// +build !nomlpack
+
+package mlpack
+
+import (
+  "math/rand"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Returns the OpenMP thread limits seen inside an Lmnn call with the given
+// Threads option, read from its progress callback, which runs on the thread
+// of the call.
+func threadsInsideCall(t *testing.T, threads int) []int {
+  t.Helper()
+  rng := rand.New(rand.NewSource(5))
+  labels := mat.NewDense(60, 1, nil)
+  for i := 0; i < 60; i++ {
+    labels.Set(i, 0, float64(i % 2))
+  }
+  var seen []int
+  p := LmnnOptions()
+  p.Labels = labels
+  p.Optimizer = OptimizerLBFGS
+  p.MaxIterations = 5
+  p.Threads = threads
+  p.Progress = func(iter int, objective float64) bool {
+    seen = append(seen, currentNumThreads())
+    return true
+  }
+  if _, _, _, err := LmnnWithError(randomMatrix(rng, 60, 3), p); err != nil {
+    t.Fatalf("Lmnn() with Threads %d failed: %v", threads, err)
+  }
+  if len(seen) == 0 {
+    t.Fatal("Lmnn() never reported progress")
+  }
+  return seen
+}
+
+func TestThreadsLimitCall(t *testing.T) {
+  defer SetNumThreads(0)
+  if defaultNumThreads() < 2 {
+    t.Skip("OpenMP uses a single thread by default")
+  }
+
+  for _, n := range threadsInsideCall(t, 1) {
+    if n != 1 {
+      t.Fatalf("a call with Threads 1 may use %d threads", n)
+    }
+  }
+  for _, n := range threadsInsideCall(t, 2) {
+    if n != 2 {
+      t.Fatalf("a call with Threads 2 may use %d threads", n)
+    }
+  }
+
+  SetNumThreads(1)
+  for _, n := range threadsInsideCall(t, 0) {
+    if n != 1 {
+      t.Fatalf("a call after SetNumThreads(1) may use %d threads", n)
+    }
+  }
+
+  // The limit only applies during the call.
+  if n := currentNumThreads(); n != defaultNumThreads() {
+    t.Errorf("after the calls this thread may use %d threads, want %d", n,
+        defaultNumThreads())
+  }
+}
@@ -0,0 +1,43 @@
+package mlpack
+
+import "testing"
+
+func TestNumThreads(t *testing.T) {
+  defer SetNumThreads(0)
+
+  SetNumThreads(3)
+  if n := NumThreads(); n != 3 {
+    t.Errorf("NumThreads() = %d after SetNumThreads(3)", n)
+  }
+  SetNumThreads(-2)
+  if n, want := NumThreads(), defaultNumThreads(); n != want {
+    t.Errorf("NumThreads() = %d after SetNumThreads(-2), want the default %d",
+        n, want)
+  }
+}
+
+func TestCallThreads(t *testing.T) {
+  defer SetNumThreads(0)
+
+  SetNumThreads(4)
+  if n, err := callThreads("knn", 0); n != 4 || err != nil {
+    t.Errorf("callThreads(0) = %d, %v; want the SetNumThreads() value 4", n,
+        err)
+  }
+  if n, err := callThreads("knn", 2); n != 2 || err != nil {
+    t.Errorf("callThreads(2) = %d, %v; want 2", n, err)
+  }
+  SetNumThreads(0)
+  if n, err := callThreads("knn", 0); n != 0 || err != nil {
+    t.Errorf("callThreads(0) = %d, %v; want 0 for the OpenMP default", n, err)
+  }
+
+  _, err := callThreads("knn", -1)
+  if err == nil {
+    t.Fatal("callThreads(-1) succeeded")
+  }
+  if err.Binding != "knn" || err.Param != "threads" {
+    t.Errorf("callThreads(-1) error is for %s/%s, want knn/threads",
+        err.Binding, err.Param)
+  }
+}
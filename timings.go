@@ -0,0 +1,8 @@
+package mlpack
+
+import "time"
+
+// Timings holds the time mlpack spent in each of its timers during a binding
+// call, keyed by timer name.  The names are the ones mlpack prints when Verbose
+// is set, for instance "tree_building" or "computing_neighbors" for Knn().
+type Timings map[string]time.Duration
@@ -0,0 +1,677 @@
+package mlpack
+
+import "strings"
+
+// A validator collects the first problem found while checking the options of
+// a binding.  The checks mirror the ones done by the mlpack programs
+// themselves, so that a bad option is reported as a *BindingError before any
+// memory is handed to mlpack.
+type validator struct {
+  binding string
+  err *BindingError
+}
+
+// Records a problem with the given parameter, unless one was already found.
+func (v *validator) fail(param, message string) {
+  if v.err == nil {
+    v.err = &BindingError{Binding: v.binding, Param: param, Message: message}
+  }
+}
+
+// Records a problem with the given parameter if ok is false.
+func (v *validator) check(ok bool, param, message string) {
+  if !ok {
+    v.fail(param, message)
+  }
+}
+
+// Checks that value is one of the allowed values of the given parameter.
+func (v *validator) oneOf(param, value string, allowed []string) {
+  for _, a := range allowed {
+    if value == a {
+      return
+    }
+  }
+  v.fail(param, "invalid value '" + value + "'; must be one of '" +
+      strings.Join(allowed, "', '") + "'")
+}
+
+// Checks that exactly one of two mutually exclusive parameters is given.
+func (v *validator) exactlyOne(a string, aSet bool, b string, bSet bool) {
+  if !aSet && !bSet {
+    v.fail(a, "either " + a + " or " + b + " must be specified")
+  } else if aSet && bSet {
+    v.fail(b, "only one of " + a + " or " + b + " may be specified")
+  }
+}
+
+// Checks that at least one of two parameters is given.
+func (v *validator) atLeastOne(a string, aSet bool, b string, bSet bool) {
+  if !aSet && !bSet {
+    v.fail(a, "either " + a + " or " + b + " must be specified")
+  }
+}
+
+// Checks that a parameter is only given together with the one it depends on.
+func (v *validator) requires(param string, set bool, other string,
+                             otherSet bool) {
+  if set && !otherSet {
+    v.fail(param, "can only be specified together with " + other)
+  }
+}
+
+// Checks that two parameters are not both given.
+func (v *validator) conflicts(a string, aSet bool, b string, bSet bool) {
+  if aSet && bSet {
+    v.fail(b, "cannot be specified together with " + a)
+  }
+}
+
+// Returns the problem found, if any.  The result must be a nil interface when
+// there was no problem, not a nil *BindingError.
+func (v *validator) result() error {
+  if v.err == nil {
+    return nil
+  }
+  return v.err
+}
+
+const (
+  mustBePositive = "must be positive"
+  mustNotBeNegative = "must not be negative"
+  mustBeProbability = "must be in the range [0, 1]"
+)
+
+// Validate checks the options of Adaboost() without calling mlpack.
+func (p *AdaboostOptionalParam) Validate() error {
+  v := validator{binding: "adaboost"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.check(p.Iterations >= 0, "iterations", mustNotBeNegative)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  v.oneOf("weak_learner", string(p.WeakLearner), adaboostWeakLearnerValues)
+  return v.result()
+}
+
+// Validate checks the options of ApproxKfn() without calling mlpack.
+func (p *ApproxKfnOptionalParam) Validate() error {
+  v := validator{binding: "approx_kfn"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.oneOf("algorithm", string(p.Algorithm), approxKfnAlgorithmValues)
+  v.check(p.K >= 0, "k", mustNotBeNegative)
+  v.check(p.NumProjections > 0, "num_projections", mustBePositive)
+  v.check(p.NumTables > 0, "num_tables", mustBePositive)
+  v.requires("exact_distances", p.ExactDistances != nil,
+      "calculate_error", p.CalculateError)
+  return v.result()
+}
+
+// Validate checks the options of BayesianLinearRegression() without calling
+// mlpack.
+func (p *BayesianLinearRegressionOptionalParam) Validate() error {
+  v := validator{binding: "bayesian_linear_regression"}
+  v.exactlyOne("input", p.Input != nil, "input_model", p.InputModel != nil)
+  v.requires("responses", p.Responses != nil, "input", p.Input != nil)
+  return v.result()
+}
+
+// Validate checks the options of Cf() without calling mlpack.
+func (p *CfOptionalParam) Validate() error {
+  v := validator{binding: "cf"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.conflicts("query", p.Query != nil,
+      "all_user_recommendations", p.AllUserRecommendations)
+  v.oneOf("algorithm", string(p.Algorithm), cfAlgorithmValues)
+  v.oneOf("interpolation", string(p.Interpolation), cfInterpolationValues)
+  v.oneOf("neighbor_search", string(p.NeighborSearch), cfNeighborSearchValues)
+  v.oneOf("normalization", string(p.Normalization), cfNormalizationValues)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.MinResidue >= 0, "min_residue", mustNotBeNegative)
+  v.check(p.Neighborhood > 0, "neighborhood", mustBePositive)
+  v.check(p.Rank >= 0, "rank", mustNotBeNegative)
+  v.check(p.Recommendations > 0, "recommendations", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of Dbscan() without calling mlpack.
+func (p *DbscanOptionalParam) Validate() error {
+  v := validator{binding: "dbscan"}
+  v.check(p.Epsilon > 0, "epsilon", mustBePositive)
+  v.check(p.MinSize > 0, "min_size", mustBePositive)
+  v.oneOf("selection_type", string(p.SelectionType), dbscanSelectionTypeValues)
+  v.oneOf("tree_type", string(p.TreeType), dbscanTreeTypeValues)
+  return v.result()
+}
+
+// Validate checks the options of DecisionTree() without calling mlpack.
+func (p *DecisionTreeOptionalParam) Validate() error {
+  v := validator{binding: "decision_tree"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.requires("weights", p.Weights != nil, "training", p.Training != nil)
+  v.requires("test_labels", p.TestLabels != nil, "test", p.Test != nil)
+  v.check(p.MaximumDepth >= 0, "maximum_depth", mustNotBeNegative)
+  v.check(p.MinimumGainSplit >= 0 && p.MinimumGainSplit <= 1,
+      "minimum_gain_split", mustBeProbability)
+  v.check(p.MinimumLeafSize > 0, "minimum_leaf_size", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of Det() without calling mlpack.
+func (p *DetOptionalParam) Validate() error {
+  v := validator{binding: "det"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.Folds >= 0, "folds", mustNotBeNegative)
+  v.check(p.MaxLeafSize > 0, "max_leaf_size", mustBePositive)
+  v.check(p.MinLeafSize > 0, "min_leaf_size", mustBePositive)
+  v.oneOf("path_format", string(p.PathFormat), detPathFormatValues)
+  return v.result()
+}
+
+// Validate checks the options of Emst() without calling mlpack.
+func (p *EmstOptionalParam) Validate() error {
+  v := validator{binding: "emst"}
+  v.check(p.LeafSize > 0, "leaf_size", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of Fastmks() without calling mlpack.
+func (p *FastmksOptionalParam) Validate() error {
+  v := validator{binding: "fastmks"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.Bandwidth > 0, "bandwidth", mustBePositive)
+  v.check(p.Base > 1, "base", "must be greater than 1")
+  v.check(p.K >= 0, "k", mustNotBeNegative)
+  v.oneOf("kernel", string(p.Kernel), fastmksKernelValues)
+  return v.result()
+}
+
+// Validate checks the options of GmmGenerate() without calling mlpack.
+func (p *GmmGenerateOptionalParam) Validate() error {
+  return nil
+}
+
+// Validate checks the options of GmmProbability() without calling mlpack.
+func (p *GmmProbabilityOptionalParam) Validate() error {
+  return nil
+}
+
+// Validate checks the options of GmmTrain() without calling mlpack.
+func (p *GmmTrainOptionalParam) Validate() error {
+  v := validator{binding: "gmm_train"}
+  v.check(p.KmeansMaxIterations >= 0, "kmeans_max_iterations",
+      mustNotBeNegative)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.Noise >= 0, "noise", mustNotBeNegative)
+  v.check(p.Percentage > 0 && p.Percentage <= 1, "percentage",
+      "must be in the range (0, 1]")
+  v.check(p.Samplings > 0, "samplings", mustBePositive)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  v.check(p.Trials > 0, "trials", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of HmmGenerate() without calling mlpack.
+func (p *HmmGenerateOptionalParam) Validate() error {
+  v := validator{binding: "hmm_generate"}
+  v.check(p.StartState >= 0, "start_state", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of HmmLoglik() without calling mlpack.
+func (p *HmmLoglikOptionalParam) Validate() error {
+  return nil
+}
+
+// Validate checks the options of HmmTrain() without calling mlpack.
+func (p *HmmTrainOptionalParam) Validate() error {
+  v := validator{binding: "hmm_train"}
+  v.oneOf("type", string(p.Type), hmmTrainTypeValues)
+  v.check(p.Gaussians >= 0, "gaussians", mustNotBeNegative)
+  v.check(p.States >= 0, "states", mustNotBeNegative)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  if p.InputModel == nil {
+    v.check(p.States > 0, "states",
+        "must be specified when no input_model is given")
+    if p.Type == HMMGMM || p.Type == HMMDiagGMM {
+      v.check(p.Gaussians > 0, "gaussians",
+          "must be specified for type '" + string(p.Type) + "'")
+    }
+  }
+  return v.result()
+}
+
+// Validate checks the options of HmmViterbi() without calling mlpack.
+func (p *HmmViterbiOptionalParam) Validate() error {
+  return nil
+}
+
+// Validate checks the options of HoeffdingTree() without calling mlpack.
+func (p *HoeffdingTreeOptionalParam) Validate() error {
+  v := validator{binding: "hoeffding_tree"}
+  v.atLeastOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.requires("test_labels", p.TestLabels != nil, "test", p.Test != nil)
+  v.check(p.Bins > 0, "bins", mustBePositive)
+  v.check(p.Confidence >= 0 && p.Confidence <= 1, "confidence",
+      mustBeProbability)
+  v.check(p.MinSamples > 0, "min_samples", mustBePositive)
+  v.check(p.MaxSamples >= p.MinSamples, "max_samples",
+      "must not be less than min_samples")
+  v.oneOf("numeric_split_strategy", string(p.NumericSplitStrategy),
+      hoeffdingTreeNumericSplitStrategyValues)
+  v.check(p.ObservationsBeforeBinning > 0, "observations_before_binning",
+      mustBePositive)
+  v.check(p.Passes > 0, "passes", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of ImageConverter() without calling mlpack.
+func (p *ImageConverterOptionalParam) Validate() error {
+  v := validator{binding: "image_converter"}
+  v.check(p.Quality >= 0 && p.Quality <= 100, "quality",
+      "must be in the range [0, 100]")
+  v.requires("dataset", p.Dataset != nil, "save", p.Save)
+  if p.Save {
+    v.check(p.Dataset != nil, "dataset", "must be specified with save")
+    v.check(p.Width > 0, "width", "must be specified with save")
+    v.check(p.Height > 0, "height", "must be specified with save")
+    v.check(p.Channels > 0, "channels", "must be specified with save")
+  }
+  return v.result()
+}
+
+// Validate checks the options of Kde() without calling mlpack.
+func (p *KdeOptionalParam) Validate() error {
+  v := validator{binding: "kde"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.AbsError >= 0, "abs_error", mustNotBeNegative)
+  v.oneOf("algorithm", string(p.Algorithm), kdeAlgorithmValues)
+  v.check(p.Bandwidth > 0, "bandwidth", mustBePositive)
+  v.check(p.InitialSampleSize > 0, "initial_sample_size", mustBePositive)
+  v.oneOf("kernel", string(p.Kernel), kdeKernelValues)
+  v.check(p.McBreakCoef > 0 && p.McBreakCoef <= 1, "mc_break_coef",
+      "must be in the range (0, 1]")
+  v.check(p.McEntryCoef >= 1, "mc_entry_coef", "must be at least 1")
+  v.check(p.McProbability >= 0 && p.McProbability < 1, "mc_probability",
+      "must be in the range [0, 1)")
+  v.check(p.RelError >= 0 && p.RelError <= 1, "rel_error",
+      mustBeProbability)
+  v.oneOf("tree", string(p.Tree), kdeTreeValues)
+  return v.result()
+}
+
+// Validate checks the options of KernelPca() without calling mlpack.  The
+// kernel is a required argument of KernelPca() and is checked by the call
+// itself.
+func (p *KernelPcaOptionalParam) Validate() error {
+  v := validator{binding: "kernel_pca"}
+  v.check(p.Bandwidth > 0, "bandwidth", mustBePositive)
+  v.check(p.NewDimensionality >= 0, "new_dimensionality", mustNotBeNegative)
+  v.oneOf("sampling", string(p.Sampling), kernelPcaSamplingValues)
+  return v.result()
+}
+
+// Validate checks the options of Kfn() without calling mlpack.
+func (p *KfnOptionalParam) Validate() error {
+  v := validator{binding: "kfn"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.oneOf("algorithm", string(p.Algorithm), kfnAlgorithmValues)
+  v.check(p.Epsilon >= 0 && p.Epsilon < 1, "epsilon",
+      "must be in the range [0, 1)")
+  v.check(p.K >= 0, "k", mustNotBeNegative)
+  v.check(p.LeafSize > 0, "leaf_size", mustBePositive)
+  v.check(p.Percentage > 0 && p.Percentage <= 1, "percentage",
+      "must be in the range (0, 1]")
+  v.oneOf("tree_type", string(p.TreeType), kfnTreeTypeValues)
+  v.requires("true_distances", p.TrueDistances != nil, "k", p.K > 0)
+  v.requires("true_neighbors", p.TrueNeighbors != nil, "k", p.K > 0)
+  return v.result()
+}
+
+// Validate checks the options of Kmeans() without calling mlpack.
+func (p *KmeansOptionalParam) Validate() error {
+  v := validator{binding: "kmeans"}
+  v.oneOf("algorithm", string(p.Algorithm), kmeansAlgorithmValues)
+  v.conflicts("allow_empty_clusters", p.AllowEmptyClusters,
+      "kill_empty_clusters", p.KillEmptyClusters)
+  v.conflicts("refined_start", p.RefinedStart,
+      "kmeans_plus_plus", p.KmeansPlusPlus)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.Percentage > 0 && p.Percentage <= 1, "percentage",
+      "must be in the range (0, 1]")
+  v.check(p.Samplings > 0, "samplings", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of Knn() without calling mlpack.
+func (p *KnnOptionalParam) Validate() error {
+  v := validator{binding: "knn"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.oneOf("algorithm", string(p.Algorithm), knnAlgorithmValues)
+  v.check(p.Epsilon >= 0, "epsilon", mustNotBeNegative)
+  v.check(p.K >= 0, "k", mustNotBeNegative)
+  v.check(p.LeafSize > 0, "leaf_size", mustBePositive)
+  v.check(p.Rho >= 0 && p.Rho <= 1, "rho", mustBeProbability)
+  v.check(p.Tau >= 0, "tau", mustNotBeNegative)
+  v.oneOf("tree_type", string(p.TreeType), knnTreeTypeValues)
+  v.requires("true_distances", p.TrueDistances != nil, "k", p.K > 0)
+  v.requires("true_neighbors", p.TrueNeighbors != nil, "k", p.K > 0)
+  return v.result()
+}
+
+// Validate checks the options of Krann() without calling mlpack.
+func (p *KrannOptionalParam) Validate() error {
+  v := validator{binding: "krann"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.Alpha > 0 && p.Alpha <= 1, "alpha",
+      "must be in the range (0, 1]")
+  v.check(p.K >= 0, "k", mustNotBeNegative)
+  v.check(p.LeafSize > 0, "leaf_size", mustBePositive)
+  v.check(p.SingleSampleLimit > 0, "single_sample_limit", mustBePositive)
+  v.check(p.Tau >= 0 && p.Tau <= 100, "tau", "must be in the range [0, 100]")
+  v.oneOf("tree_type", string(p.TreeType), krannTreeTypeValues)
+  return v.result()
+}
+
+// Validate checks the options of Lars() without calling mlpack.
+func (p *LarsOptionalParam) Validate() error {
+  v := validator{binding: "lars"}
+  v.exactlyOne("input", p.Input != nil, "input_model", p.InputModel != nil)
+  v.requires("responses", p.Responses != nil, "input", p.Input != nil)
+  v.check(p.Lambda1 >= 0, "lambda1", mustNotBeNegative)
+  v.check(p.Lambda2 >= 0, "lambda2", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of LinearRegression() without calling mlpack.
+func (p *LinearRegressionOptionalParam) Validate() error {
+  v := validator{binding: "linear_regression"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("training_responses", p.TrainingResponses != nil,
+      "training", p.Training != nil)
+  v.check(p.Lambda >= 0, "lambda", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of LinearSvm() without calling mlpack.
+func (p *LinearSvmOptionalParam) Validate() error {
+  v := validator{binding: "linear_svm"}
+  v.atLeastOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.requires("test_labels", p.TestLabels != nil, "test", p.Test != nil)
+  v.check(p.Delta >= 0, "delta", mustNotBeNegative)
+  v.check(p.Epochs > 0, "epochs", mustBePositive)
+  v.check(p.Lambda >= 0, "lambda", mustNotBeNegative)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.NumClasses >= 0, "num_classes", mustNotBeNegative)
+  v.oneOf("optimizer", string(p.Optimizer), linearSvmOptimizerValues)
+  v.check(p.StepSize > 0, "step_size", mustBePositive)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of Lmnn() without calling mlpack.
+func (p *LmnnOptionalParam) Validate() error {
+  v := validator{binding: "lmnn"}
+  v.check(p.BatchSize > 0, "batch_size", mustBePositive)
+  v.check(p.K > 0, "k", mustBePositive)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.oneOf("optimizer", string(p.Optimizer), lmnnOptimizerValues)
+  v.check(p.Passes >= 0, "passes", mustNotBeNegative)
+  v.check(p.Rank >= 0, "rank", mustNotBeNegative)
+  v.check(p.Regularization >= 0, "regularization", mustNotBeNegative)
+  v.check(p.StepSize > 0, "step_size", mustBePositive)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  v.check(p.UpdateInterval > 0, "update_interval", mustBePositive)
+  return v.result()
+}
+
+// Validate checks the options of LocalCoordinateCoding() without calling
+// mlpack.
+func (p *LocalCoordinateCodingOptionalParam) Validate() error {
+  v := validator{binding: "local_coordinate_coding"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.Atoms >= 0, "atoms", mustNotBeNegative)
+  if p.Training != nil {
+    v.check(p.Atoms > 0, "atoms", "must be specified when training")
+  }
+  v.requires("initial_dictionary", p.InitialDictionary != nil,
+      "training", p.Training != nil)
+  v.check(p.Lambda >= 0, "lambda", mustNotBeNegative)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of LogisticRegression() without calling mlpack.
+func (p *LogisticRegressionOptionalParam) Validate() error {
+  v := validator{binding: "logistic_regression"}
+  v.atLeastOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.check(p.BatchSize > 0, "batch_size", mustBePositive)
+  v.check(p.DecisionBoundary >= 0 && p.DecisionBoundary <= 1,
+      "decision_boundary", mustBeProbability)
+  v.check(p.Lambda >= 0, "lambda", mustNotBeNegative)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.oneOf("optimizer", string(p.Optimizer), logisticRegressionOptimizerValues)
+  v.check(p.StepSize > 0, "step_size", mustBePositive)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of Lsh() without calling mlpack.
+func (p *LshOptionalParam) Validate() error {
+  v := validator{binding: "lsh"}
+  v.exactlyOne("reference", p.Reference != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.BucketSize > 0, "bucket_size", mustBePositive)
+  v.check(p.HashWidth >= 0, "hash_width", mustNotBeNegative)
+  v.check(p.K >= 0, "k", mustNotBeNegative)
+  v.check(p.NumProbes >= 0, "num_probes", mustNotBeNegative)
+  v.check(p.Projections > 0, "projections", mustBePositive)
+  v.check(p.SecondHashSize > 0, "second_hash_size", mustBePositive)
+  v.check(p.Tables > 0, "tables", mustBePositive)
+  v.requires("true_neighbors", p.TrueNeighbors != nil, "k", p.K > 0)
+  return v.result()
+}
+
+// Validate checks the options of MeanShift() without calling mlpack.
+func (p *MeanShiftOptionalParam) Validate() error {
+  v := validator{binding: "mean_shift"}
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of Nbc() without calling mlpack.
+func (p *NbcOptionalParam) Validate() error {
+  v := validator{binding: "nbc"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  return v.result()
+}
+
+// Validate checks the options of Nca() without calling mlpack.
+func (p *NcaOptionalParam) Validate() error {
+  v := validator{binding: "nca"}
+  v.check(p.ArmijoConstant > 0 && p.ArmijoConstant < 1, "armijo_constant",
+      "must be in the range (0, 1)")
+  v.check(p.BatchSize > 0, "batch_size", mustBePositive)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.MaxLineSearchTrials > 0, "max_line_search_trials",
+      mustBePositive)
+  v.check(p.MinStep > 0, "min_step", mustBePositive)
+  v.check(p.MaxStep >= p.MinStep, "max_step",
+      "must not be less than min_step")
+  v.check(p.NumBasis > 0, "num_basis", mustBePositive)
+  v.oneOf("optimizer", string(p.Optimizer), ncaOptimizerValues)
+  v.check(p.StepSize > 0, "step_size", mustBePositive)
+  v.check(p.Tolerance >= 0, "tolerance", mustNotBeNegative)
+  v.check(p.Wolfe > 0 && p.Wolfe < 1, "wolfe", "must be in the range (0, 1)")
+  return v.result()
+}
+
+// Validate checks the options of Nmf() without calling mlpack.
+func (p *NmfOptionalParam) Validate() error {
+  v := validator{binding: "nmf"}
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.MinResidue >= 0, "min_residue", mustNotBeNegative)
+  v.oneOf("update_rules", string(p.UpdateRules), nmfUpdateRulesValues)
+  return v.result()
+}
+
+// Validate checks the options of Pca() without calling mlpack.
+func (p *PcaOptionalParam) Validate() error {
+  v := validator{binding: "pca"}
+  v.oneOf("decomposition_method", string(p.DecompositionMethod),
+      pcaDecompositionMethodValues)
+  v.check(p.NewDimensionality >= 0, "new_dimensionality", mustNotBeNegative)
+  v.check(p.VarToRetain >= 0 && p.VarToRetain <= 1, "var_to_retain",
+      mustBeProbability)
+  return v.result()
+}
+
+// Validate checks the options of Perceptron() without calling mlpack.
+func (p *PerceptronOptionalParam) Validate() error {
+  v := validator{binding: "perceptron"}
+  v.atLeastOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of PreprocessBinarize() without calling mlpack.
+func (p *PreprocessBinarizeOptionalParam) Validate() error {
+  v := validator{binding: "preprocess_binarize"}
+  v.check(p.Dimension >= 0, "dimension", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of PreprocessDescribe() without calling mlpack.
+func (p *PreprocessDescribeOptionalParam) Validate() error {
+  v := validator{binding: "preprocess_describe"}
+  v.check(p.Dimension >= 0, "dimension", mustNotBeNegative)
+  v.check(p.Precision >= 0, "precision", mustNotBeNegative)
+  v.check(p.Width >= 0, "width", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of PreprocessOneHotEncoding() without calling
+// mlpack.
+func (p *PreprocessOneHotEncodingOptionalParam) Validate() error {
+  v := validator{binding: "preprocess_one_hot_encoding"}
+  for _, d := range p.Dimensions {
+    v.check(d >= 0, "dimensions", "dimensions must not be negative")
+  }
+  return v.result()
+}
+
+// Validate checks the options of PreprocessScale() without calling mlpack.
+func (p *PreprocessScaleOptionalParam) Validate() error {
+  v := validator{binding: "preprocess_scale"}
+  v.check(p.Epsilon >= -1 && p.Epsilon <= 1, "epsilon",
+      "must be in the range [-1, 1]")
+  v.oneOf("scaler_method", string(p.ScalerMethod),
+      preprocessScaleScalerMethodValues)
+  if p.ScalerMethod == ScalerMinMax {
+    v.check(p.MinValue < p.MaxValue, "max_value",
+        "must be greater than min_value")
+  }
+  return v.result()
+}
+
+// Validate checks the options of PreprocessSplit() without calling mlpack.
+func (p *PreprocessSplitOptionalParam) Validate() error {
+  v := validator{binding: "preprocess_split"}
+  v.requires("stratify_data", p.StratifyData,
+      "input_labels", p.InputLabels != nil)
+  v.check(p.TestRatio >= 0 && p.TestRatio <= 1, "test_ratio",
+      mustBeProbability)
+  return v.result()
+}
+
+// Validate checks the options of Radical() without calling mlpack.
+func (p *RadicalOptionalParam) Validate() error {
+  v := validator{binding: "radical"}
+  v.check(p.Angles > 0, "angles", mustBePositive)
+  v.check(p.NoiseStdDev >= 0, "noise_std_dev", mustNotBeNegative)
+  v.check(p.Replicates > 0, "replicates", mustBePositive)
+  v.check(p.Sweeps >= 0, "sweeps", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of RandomForest() without calling mlpack.
+func (p *RandomForestOptionalParam) Validate() error {
+  v := validator{binding: "random_forest"}
+  v.atLeastOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  if !p.WarmStart {
+    v.conflicts("training", p.Training != nil,
+        "input_model", p.InputModel != nil)
+  } else {
+    v.check(p.Training != nil && p.InputModel != nil, "warm_start",
+        "requires both training and input_model")
+  }
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.requires("test_labels", p.TestLabels != nil, "test", p.Test != nil)
+  v.check(p.MaximumDepth >= 0, "maximum_depth", mustNotBeNegative)
+  v.check(p.MinimumGainSplit >= 0, "minimum_gain_split", mustNotBeNegative)
+  v.check(p.MinimumLeafSize > 0, "minimum_leaf_size", mustBePositive)
+  v.check(p.NumTrees > 0, "num_trees", mustBePositive)
+  v.check(p.SubspaceDim >= 0, "subspace_dim", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of SoftmaxRegression() without calling mlpack.
+func (p *SoftmaxRegressionOptionalParam) Validate() error {
+  v := validator{binding: "softmax_regression"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.requires("labels", p.Labels != nil, "training", p.Training != nil)
+  v.requires("test_labels", p.TestLabels != nil, "test", p.Test != nil)
+  v.check(p.Lambda >= 0, "lambda", mustNotBeNegative)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.NumberOfClasses >= 0, "number_of_classes", mustNotBeNegative)
+  return v.result()
+}
+
+// Validate checks the options of SparseCoding() without calling mlpack.
+func (p *SparseCodingOptionalParam) Validate() error {
+  v := validator{binding: "sparse_coding"}
+  v.exactlyOne("training", p.Training != nil,
+      "input_model", p.InputModel != nil)
+  v.check(p.Atoms > 0, "atoms", mustBePositive)
+  v.requires("initial_dictionary", p.InitialDictionary != nil,
+      "training", p.Training != nil)
+  v.check(p.Lambda1 >= 0, "lambda1", mustNotBeNegative)
+  v.check(p.Lambda2 >= 0, "lambda2", mustNotBeNegative)
+  v.check(p.MaxIterations >= 0, "max_iterations", mustNotBeNegative)
+  v.check(p.NewtonTolerance >= 0, "newton_tolerance", mustNotBeNegative)
+  v.check(p.ObjectiveTolerance >= 0, "objective_tolerance",
+      mustNotBeNegative)
+  return v.result()
+}
+
+// Checks the kernel passed to KernelPca().
+func validateKernelPcaKernel(kernel Kernel) error {
+  v := validator{binding: "kernel_pca"}
+  v.oneOf("kernel", string(kernel), kernelPcaKernelValues)
+  return v.result()
+}
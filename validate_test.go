@@ -0,0 +1,915 @@
+package mlpack
+
+import (
+  "strings"
+  "testing"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// The options structs of all bindings have a Validate() method.
+type validatable interface {
+  Validate() error
+}
+
+// A Validate() case: the options to check, and the parameter that the error
+// must be about, or "" if the options are valid.
+type validateCase struct {
+  name string
+  options validatable
+  param string
+}
+
+// Any matrix, to mark a matrix option as given.
+var given = mat.NewDense(1, 1, nil)
+
+// Checks the cases of the given binding.
+func checkValidate(t *testing.T, binding string, cases []validateCase) {
+  t.Helper()
+  for _, c := range cases {
+    err := c.options.Validate()
+    if c.param == "" {
+      if err != nil {
+        t.Errorf("%s: %s: Validate() failed: %v", binding, c.name, err)
+      }
+      continue
+    }
+    berr, ok := err.(*BindingError)
+    if !ok {
+      t.Errorf("%s: %s: Validate() = %v, want a *BindingError for %s",
+          binding, c.name, err, c.param)
+      continue
+    }
+    if berr.Binding != binding || berr.Param != c.param {
+      t.Errorf("%s: %s: Validate() error is for %s/%s, want %s/%s", binding,
+          c.name, berr.Binding, berr.Param, binding, c.param)
+    }
+  }
+}
+
+func TestValidateMessages(t *testing.T) {
+  p := KnnOptions()
+  p.Reference = given
+  p.TreeType = "octree"
+  err := p.Validate()
+  if err == nil || !strings.Contains(err.Error(),
+      "invalid value 'octree'; must be one of 'kd', 'vp'") {
+    t.Errorf("Validate() of an invalid tree type = %v", err)
+  }
+
+  p = KnnOptions()
+  if err := p.Validate(); err == nil ||
+      !strings.Contains(err.Error(), "either reference or input_model") {
+    t.Errorf("Validate() without reference or input_model = %v", err)
+  }
+  p.Reference = given
+  p.InputModel = &KNNModel{}
+  if err := p.Validate(); err == nil ||
+      !strings.Contains(err.Error(), "only one of reference or input_model") {
+    t.Errorf("Validate() with reference and input_model = %v", err)
+  }
+
+  // A valid struct must give a nil interface, not a nil *BindingError.
+  p.InputModel = nil
+  if err := p.Validate(); err != nil {
+    t.Errorf("Validate() of valid options = %#v, want nil", err)
+  }
+}
+
+func TestValidateAllowedValues(t *testing.T) {
+  lists := map[string][]string{
+    "knn tree_type": knnTreeTypeValues,
+    "kfn tree_type": kfnTreeTypeValues,
+    "krann tree_type": krannTreeTypeValues,
+    "kde kernel": kdeKernelValues,
+    "kernel_pca kernel": kernelPcaKernelValues,
+  }
+  for name, values := range lists {
+    seen := make(map[string]bool)
+    for _, v := range values {
+      if v == "" || seen[v] {
+        t.Errorf("%s: empty or repeated value %q", name, v)
+      }
+      seen[v] = true
+    }
+  }
+  // Spill trees are only supported by knn.
+  if !contains(knnTreeTypeValues, string(TreeSpill)) ||
+      contains(kfnTreeTypeValues, string(TreeSpill)) {
+    t.Error("TreeSpill must be allowed for knn only")
+  }
+  for _, kernel := range kdeKernelValues {
+    p := KdeOptions()
+    p.Reference = given
+    p.Kernel = Kernel(kernel)
+    if err := p.Validate(); err != nil {
+      t.Errorf("Validate() of kde kernel %q failed: %v", kernel, err)
+    }
+  }
+  if err := validateKernelPcaKernel(KernelSpherical); err == nil {
+    t.Error("kernel_pca accepted the spherical kernel")
+  }
+  if err := validateKernelPcaKernel(KernelCosine); err != nil {
+    t.Errorf("kernel_pca rejected the cosine kernel: %v", err)
+  }
+}
+
+func contains(values []string, value string) bool {
+  for _, v := range values {
+    if v == value {
+      return true
+    }
+  }
+  return false
+}
+
+func TestValidateAdaboost(t *testing.T) {
+  opts := func(f func(p *AdaboostOptionalParam)) *AdaboostOptionalParam {
+    p := AdaboostOptions()
+    p.Training = given
+    p.Labels = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "adaboost", []validateCase{
+    {"defaults", opts(func(p *AdaboostOptionalParam) {}), ""},
+    {"input model", opts(func(p *AdaboostOptionalParam) {
+      p.Training, p.Labels, p.InputModel = nil, nil, &AdaBoostModel{}
+    }), ""},
+    {"neither", opts(func(p *AdaboostOptionalParam) {
+      p.Training, p.Labels = nil, nil
+    }), "training"},
+    {"both", opts(func(p *AdaboostOptionalParam) {
+      p.InputModel = &AdaBoostModel{}
+    }), "input_model"},
+    {"labels without training", opts(func(p *AdaboostOptionalParam) {
+      p.Training, p.InputModel = nil, &AdaBoostModel{}
+    }), "labels"},
+    {"negative iterations", opts(func(p *AdaboostOptionalParam) {
+      p.Iterations = -1
+    }), "iterations"},
+    {"negative tolerance", opts(func(p *AdaboostOptionalParam) {
+      p.Tolerance = -1e-3
+    }), "tolerance"},
+    {"invalid weak learner", opts(func(p *AdaboostOptionalParam) {
+      p.WeakLearner = "tree"
+    }), "weak_learner"},
+  })
+}
+
+func TestValidateApproxKfn(t *testing.T) {
+  opts := func(f func(p *ApproxKfnOptionalParam)) *ApproxKfnOptionalParam {
+    p := ApproxKfnOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "approx_kfn", []validateCase{
+    {"defaults", opts(func(p *ApproxKfnOptionalParam) {}), ""},
+    {"neither", opts(func(p *ApproxKfnOptionalParam) {
+      p.Reference = nil
+    }), "reference"},
+    {"invalid algorithm", opts(func(p *ApproxKfnOptionalParam) {
+      p.Algorithm = "exact"
+    }), "algorithm"},
+    {"zero tables", opts(func(p *ApproxKfnOptionalParam) {
+      p.NumTables = 0
+    }), "num_tables"},
+    {"exact distances without calculate error",
+        opts(func(p *ApproxKfnOptionalParam) {
+      p.ExactDistances = given
+    }), "exact_distances"},
+    {"exact distances with calculate error",
+        opts(func(p *ApproxKfnOptionalParam) {
+      p.ExactDistances = given
+      p.CalculateError = true
+    }), ""},
+  })
+}
+
+func TestValidateBayesianLinearRegression(t *testing.T) {
+  type options = BayesianLinearRegressionOptionalParam
+  opts := func(f func(p *options)) *options {
+    p := BayesianLinearRegressionOptions()
+    p.Input = given
+    p.Responses = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "bayesian_linear_regression", []validateCase{
+    {"defaults", opts(func(p *options) {}), ""},
+    {"neither", opts(func(p *options) {
+      p.Input, p.Responses = nil, nil
+    }), "input"},
+    {"responses without input", opts(func(p *options) {
+      p.Input, p.InputModel = nil, &BayesianLinearRegressionModel{}
+    }), "responses"},
+  })
+}
+
+func TestValidateCf(t *testing.T) {
+  opts := func(f func(p *CfOptionalParam)) *CfOptionalParam {
+    p := CfOptions()
+    p.Training = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "cf", []validateCase{
+    {"defaults", opts(func(p *CfOptionalParam) {}), ""},
+    {"both", opts(func(p *CfOptionalParam) {
+      p.InputModel = &CFModel{}
+    }), "input_model"},
+    {"query with all user recommendations", opts(func(p *CfOptionalParam) {
+      p.Query = given
+      p.AllUserRecommendations = true
+    }), "all_user_recommendations"},
+    {"invalid algorithm", opts(func(p *CfOptionalParam) {
+      p.Algorithm = "ALS"
+    }), "algorithm"},
+    {"invalid interpolation", opts(func(p *CfOptionalParam) {
+      p.Interpolation = "median"
+    }), "interpolation"},
+    {"invalid neighbor search", opts(func(p *CfOptionalParam) {
+      p.NeighborSearch = "manhattan"
+    }), "neighbor_search"},
+    {"invalid normalization", opts(func(p *CfOptionalParam) {
+      p.Normalization = "max"
+    }), "normalization"},
+    {"zero neighborhood", opts(func(p *CfOptionalParam) {
+      p.Neighborhood = 0
+    }), "neighborhood"},
+    {"zero recommendations", opts(func(p *CfOptionalParam) {
+      p.Recommendations = 0
+    }), "recommendations"},
+  })
+}
+
+func TestValidateDbscan(t *testing.T) {
+  opts := func(f func(p *DbscanOptionalParam)) *DbscanOptionalParam {
+    p := DbscanOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "dbscan", []validateCase{
+    {"defaults", opts(func(p *DbscanOptionalParam) {}), ""},
+    {"zero epsilon", opts(func(p *DbscanOptionalParam) {
+      p.Epsilon = 0
+    }), "epsilon"},
+    {"zero min size", opts(func(p *DbscanOptionalParam) {
+      p.MinSize = 0
+    }), "min_size"},
+    {"invalid selection type", opts(func(p *DbscanOptionalParam) {
+      p.SelectionType = "shuffled"
+    }), "selection_type"},
+    {"tree type of knn only", opts(func(p *DbscanOptionalParam) {
+      p.TreeType = TreeSpill
+    }), "tree_type"},
+  })
+}
+
+func TestValidateDecisionTree(t *testing.T) {
+  type options = DecisionTreeOptionalParam
+  opts := func(f func(p *options)) *options {
+    p := DecisionTreeOptions()
+    p.Training = DataAndInfo()
+    p.Labels = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "decision_tree", []validateCase{
+    {"defaults", opts(func(p *options) {}), ""},
+    {"weights without training", opts(func(p *options) {
+      p.Training, p.Labels = nil, nil
+      p.InputModel = &DecisionTreeModel{}
+      p.Weights = given
+    }), "weights"},
+    {"test labels without test", opts(func(p *options) {
+      p.TestLabels = given
+    }), "test_labels"},
+    {"minimum gain split above 1", opts(func(p *options) {
+      p.MinimumGainSplit = 1.5
+    }), "minimum_gain_split"},
+    {"zero minimum leaf size", opts(func(p *options) {
+      p.MinimumLeafSize = 0
+    }), "minimum_leaf_size"},
+  })
+}
+
+func TestValidateDet(t *testing.T) {
+  opts := func(f func(p *DetOptionalParam)) *DetOptionalParam {
+    p := DetOptions()
+    p.Training = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "det", []validateCase{
+    {"defaults", opts(func(p *DetOptionalParam) {}), ""},
+    {"neither", opts(func(p *DetOptionalParam) { p.Training = nil }),
+        "training"},
+    {"zero max leaf size", opts(func(p *DetOptionalParam) {
+      p.MaxLeafSize = 0
+    }), "max_leaf_size"},
+    {"invalid path format", opts(func(p *DetOptionalParam) {
+      p.PathFormat = "rl"
+    }), "path_format"},
+  })
+}
+
+func TestValidateEmst(t *testing.T) {
+  p := EmstOptions()
+  bad := EmstOptions()
+  bad.LeafSize = 0
+  checkValidate(t, "emst", []validateCase{
+    {"defaults", p, ""},
+    {"zero leaf size", bad, "leaf_size"},
+  })
+}
+
+func TestValidateFastmks(t *testing.T) {
+  opts := func(f func(p *FastmksOptionalParam)) *FastmksOptionalParam {
+    p := FastmksOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "fastmks", []validateCase{
+    {"defaults", opts(func(p *FastmksOptionalParam) {}), ""},
+    {"both", opts(func(p *FastmksOptionalParam) {
+      p.InputModel = &FastMKSModel{}
+    }), "input_model"},
+    {"base of 1", opts(func(p *FastmksOptionalParam) { p.Base = 1 }), "base"},
+    {"zero bandwidth", opts(func(p *FastmksOptionalParam) {
+      p.Bandwidth = 0
+    }), "bandwidth"},
+    {"kernel of kde only", opts(func(p *FastmksOptionalParam) {
+      p.Kernel = KernelLaplacian
+    }), "kernel"},
+  })
+}
+
+func TestValidateGmm(t *testing.T) {
+  opts := func(f func(p *GmmTrainOptionalParam)) *GmmTrainOptionalParam {
+    p := GmmTrainOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "gmm_train", []validateCase{
+    {"defaults", opts(func(p *GmmTrainOptionalParam) {}), ""},
+    {"zero percentage", opts(func(p *GmmTrainOptionalParam) {
+      p.Percentage = 0
+    }), "percentage"},
+    {"negative noise", opts(func(p *GmmTrainOptionalParam) {
+      p.Noise = -1
+    }), "noise"},
+    {"zero trials", opts(func(p *GmmTrainOptionalParam) { p.Trials = 0 }),
+        "trials"},
+  })
+  checkValidate(t, "gmm_generate", []validateCase{
+    {"defaults", GmmGenerateOptions(), ""},
+  })
+  checkValidate(t, "gmm_probability", []validateCase{
+    {"defaults", GmmProbabilityOptions(), ""},
+  })
+}
+
+func TestValidateHmm(t *testing.T) {
+  opts := func(f func(p *HmmTrainOptionalParam)) *HmmTrainOptionalParam {
+    p := HmmTrainOptions()
+    p.States = 2
+    f(p)
+    return p
+  }
+  checkValidate(t, "hmm_train", []validateCase{
+    {"defaults", opts(func(p *HmmTrainOptionalParam) {}), ""},
+    {"no states", opts(func(p *HmmTrainOptionalParam) { p.States = 0 }),
+        "states"},
+    {"no states with input model", opts(func(p *HmmTrainOptionalParam) {
+      p.States = 0
+      p.InputModel = &HMMModel{}
+    }), ""},
+    {"gmm without gaussians", opts(func(p *HmmTrainOptionalParam) {
+      p.Type = HMMGMM
+    }), "gaussians"},
+    {"gmm with gaussians", opts(func(p *HmmTrainOptionalParam) {
+      p.Type = HMMGMM
+      p.Gaussians = 3
+    }), ""},
+    {"invalid type", opts(func(p *HmmTrainOptionalParam) {
+      p.Type = "poisson"
+    }), "type"},
+  })
+  bad := HmmGenerateOptions()
+  bad.StartState = -1
+  checkValidate(t, "hmm_generate", []validateCase{
+    {"defaults", HmmGenerateOptions(), ""},
+    {"negative start state", bad, "start_state"},
+  })
+  checkValidate(t, "hmm_loglik", []validateCase{
+    {"defaults", HmmLoglikOptions(), ""},
+  })
+  checkValidate(t, "hmm_viterbi", []validateCase{
+    {"defaults", HmmViterbiOptions(), ""},
+  })
+}
+
+func TestValidateHoeffdingTree(t *testing.T) {
+  type options = HoeffdingTreeOptionalParam
+  opts := func(f func(p *options)) *options {
+    p := HoeffdingTreeOptions()
+    p.Training = DataAndInfo()
+    p.Labels = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "hoeffding_tree", []validateCase{
+    {"defaults", opts(func(p *options) {}), ""},
+    {"training and input model", opts(func(p *options) {
+      p.InputModel = &HoeffdingTreeModel{}
+    }), ""},
+    {"neither", opts(func(p *options) { p.Training, p.Labels = nil, nil }),
+        "training"},
+    {"confidence above 1", opts(func(p *options) { p.Confidence = 2 }),
+        "confidence"},
+    {"max samples below min samples", opts(func(p *options) {
+      p.MinSamples = 10
+      p.MaxSamples = 5
+    }), "max_samples"},
+    {"invalid split strategy", opts(func(p *options) {
+      p.NumericSplitStrategy = "gini"
+    }), "numeric_split_strategy"},
+  })
+}
+
+func TestValidateImageConverter(t *testing.T) {
+  type options = ImageConverterOptionalParam
+  opts := func(f func(p *options)) *options {
+    p := ImageConverterOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "image_converter", []validateCase{
+    {"defaults", opts(func(p *options) {}), ""},
+    {"save", opts(func(p *options) {
+      p.Save = true
+      p.Dataset = given
+      p.Width, p.Height, p.Channels = 1, 1, 3
+    }), ""},
+    {"save without width", opts(func(p *options) {
+      p.Save = true
+      p.Dataset = given
+      p.Height, p.Channels = 1, 3
+    }), "width"},
+    {"dataset without save", opts(func(p *options) { p.Dataset = given }),
+        "dataset"},
+    {"quality above 100", opts(func(p *options) { p.Quality = 101 }),
+        "quality"},
+  })
+}
+
+func TestValidateKde(t *testing.T) {
+  opts := func(f func(p *KdeOptionalParam)) *KdeOptionalParam {
+    p := KdeOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "kde", []validateCase{
+    {"defaults", opts(func(p *KdeOptionalParam) {}), ""},
+    {"invalid algorithm", opts(func(p *KdeOptionalParam) {
+      p.Algorithm = "naive"
+    }), "algorithm"},
+    {"kernel of fastmks only", opts(func(p *KdeOptionalParam) {
+      p.Kernel = KernelPolynomial
+    }), "kernel"},
+    {"invalid tree", opts(func(p *KdeOptionalParam) { p.Tree = "vp" }),
+        "tree"},
+    {"mc probability of 1", opts(func(p *KdeOptionalParam) {
+      p.McProbability = 1
+    }), "mc_probability"},
+    {"mc entry coef below 1", opts(func(p *KdeOptionalParam) {
+      p.McEntryCoef = 0.5
+    }), "mc_entry_coef"},
+    {"rel error above 1", opts(func(p *KdeOptionalParam) {
+      p.RelError = 1.5
+    }), "rel_error"},
+  })
+}
+
+func TestValidateKernelPca(t *testing.T) {
+  opts := func(f func(p *KernelPcaOptionalParam)) *KernelPcaOptionalParam {
+    p := KernelPcaOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "kernel_pca", []validateCase{
+    {"defaults", opts(func(p *KernelPcaOptionalParam) {}), ""},
+    {"invalid sampling", opts(func(p *KernelPcaOptionalParam) {
+      p.Sampling = "uniform"
+    }), "sampling"},
+    {"zero bandwidth", opts(func(p *KernelPcaOptionalParam) {
+      p.Bandwidth = 0
+    }), "bandwidth"},
+  })
+}
+
+func TestValidateKfn(t *testing.T) {
+  opts := func(f func(p *KfnOptionalParam)) *KfnOptionalParam {
+    p := KfnOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "kfn", []validateCase{
+    {"defaults", opts(func(p *KfnOptionalParam) {}), ""},
+    {"epsilon of 1", opts(func(p *KfnOptionalParam) { p.Epsilon = 1 }),
+        "epsilon"},
+    {"zero percentage", opts(func(p *KfnOptionalParam) { p.Percentage = 0 }),
+        "percentage"},
+    {"spill tree", opts(func(p *KfnOptionalParam) { p.TreeType = TreeSpill }),
+        "tree_type"},
+    {"true neighbors without k", opts(func(p *KfnOptionalParam) {
+      p.TrueNeighbors = given
+    }), "true_neighbors"},
+  })
+}
+
+func TestValidateKmeans(t *testing.T) {
+  opts := func(f func(p *KmeansOptionalParam)) *KmeansOptionalParam {
+    p := KmeansOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "kmeans", []validateCase{
+    {"defaults", opts(func(p *KmeansOptionalParam) {}), ""},
+    {"invalid algorithm", opts(func(p *KmeansOptionalParam) {
+      p.Algorithm = "lloyd"
+    }), "algorithm"},
+    {"allow and kill empty clusters", opts(func(p *KmeansOptionalParam) {
+      p.AllowEmptyClusters = true
+      p.KillEmptyClusters = true
+    }), "kill_empty_clusters"},
+    {"refined start and kmeans++", opts(func(p *KmeansOptionalParam) {
+      p.RefinedStart = true
+      p.KmeansPlusPlus = true
+    }), "kmeans_plus_plus"},
+  })
+}
+
+func TestValidateKnn(t *testing.T) {
+  opts := func(f func(p *KnnOptionalParam)) *KnnOptionalParam {
+    p := KnnOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "knn", []validateCase{
+    {"defaults", opts(func(p *KnnOptionalParam) {}), ""},
+    {"input model", opts(func(p *KnnOptionalParam) {
+      p.Reference, p.InputModel = nil, &KNNModel{}
+    }), ""},
+    {"neither", opts(func(p *KnnOptionalParam) { p.Reference = nil }),
+        "reference"},
+    {"both", opts(func(p *KnnOptionalParam) { p.InputModel = &KNNModel{} }),
+        "input_model"},
+    {"spill tree", opts(func(p *KnnOptionalParam) { p.TreeType = TreeSpill }),
+        ""},
+    {"invalid tree type", opts(func(p *KnnOptionalParam) {
+      p.TreeType = "octree"
+    }), "tree_type"},
+    {"invalid algorithm", opts(func(p *KnnOptionalParam) {
+      p.Algorithm = "brute"
+    }), "algorithm"},
+    {"negative k", opts(func(p *KnnOptionalParam) { p.K = -1 }), "k"},
+    {"zero leaf size", opts(func(p *KnnOptionalParam) { p.LeafSize = 0 }),
+        "leaf_size"},
+    {"rho above 1", opts(func(p *KnnOptionalParam) { p.Rho = 1.5 }), "rho"},
+    {"true distances without k", opts(func(p *KnnOptionalParam) {
+      p.TrueDistances = given
+    }), "true_distances"},
+    {"true distances with k", opts(func(p *KnnOptionalParam) {
+      p.TrueDistances = given
+      p.K = 3
+    }), ""},
+  })
+}
+
+func TestValidateKrann(t *testing.T) {
+  opts := func(f func(p *KrannOptionalParam)) *KrannOptionalParam {
+    p := KrannOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "krann", []validateCase{
+    {"defaults", opts(func(p *KrannOptionalParam) {}), ""},
+    {"both", opts(func(p *KrannOptionalParam) { p.InputModel = &RAModel{} }),
+        "input_model"},
+    {"zero alpha", opts(func(p *KrannOptionalParam) { p.Alpha = 0 }),
+        "alpha"},
+    {"tau above 100", opts(func(p *KrannOptionalParam) { p.Tau = 101 }),
+        "tau"},
+    {"tree type of knn only", opts(func(p *KrannOptionalParam) {
+      p.TreeType = TreeVP
+    }), "tree_type"},
+  })
+}
+
+func TestValidateRegression(t *testing.T) {
+  lars := func(f func(p *LarsOptionalParam)) *LarsOptionalParam {
+    p := LarsOptions()
+    p.Input = given
+    p.Responses = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "lars", []validateCase{
+    {"defaults", lars(func(p *LarsOptionalParam) {}), ""},
+    {"both", lars(func(p *LarsOptionalParam) {
+      p.InputModel = &LARSModel{}
+    }), "input_model"},
+    {"negative lambda1", lars(func(p *LarsOptionalParam) {
+      p.Lambda1 = -1
+    }), "lambda1"},
+  })
+
+  type linear = LinearRegressionOptionalParam
+  lr := func(f func(p *linear)) *linear {
+    p := LinearRegressionOptions()
+    p.Training = given
+    p.TrainingResponses = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "linear_regression", []validateCase{
+    {"defaults", lr(func(p *linear) {}), ""},
+    {"responses without training", lr(func(p *linear) {
+      p.Training, p.InputModel = nil, &LinearRegressionModel{}
+    }), "training_responses"},
+    {"negative lambda", lr(func(p *linear) { p.Lambda = -1 }), "lambda"},
+  })
+}
+
+func TestValidateLinearSvm(t *testing.T) {
+  opts := func(f func(p *LinearSvmOptionalParam)) *LinearSvmOptionalParam {
+    p := LinearSvmOptions()
+    p.Training = given
+    p.Labels = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "linear_svm", []validateCase{
+    {"defaults", opts(func(p *LinearSvmOptionalParam) {}), ""},
+    {"neither", opts(func(p *LinearSvmOptionalParam) {
+      p.Training, p.Labels = nil, nil
+    }), "training"},
+    {"optimizer of logistic regression only",
+        opts(func(p *LinearSvmOptionalParam) {
+      p.Optimizer = OptimizerSGD
+    }), "optimizer"},
+    {"zero epochs", opts(func(p *LinearSvmOptionalParam) { p.Epochs = 0 }),
+        "epochs"},
+  })
+}
+
+func TestValidateLmnn(t *testing.T) {
+  opts := func(f func(p *LmnnOptionalParam)) *LmnnOptionalParam {
+    p := LmnnOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "lmnn", []validateCase{
+    {"defaults", opts(func(p *LmnnOptionalParam) {}), ""},
+    {"invalid optimizer", opts(func(p *LmnnOptionalParam) {
+      p.Optimizer = OptimizerPSGD
+    }), "optimizer"},
+    {"zero k", opts(func(p *LmnnOptionalParam) { p.K = 0 }), "k"},
+    {"zero step size", opts(func(p *LmnnOptionalParam) { p.StepSize = 0 }),
+        "step_size"},
+  })
+}
+
+func TestValidateLocalCoordinateCoding(t *testing.T) {
+  type options = LocalCoordinateCodingOptionalParam
+  opts := func(f func(p *options)) *options {
+    p := LocalCoordinateCodingOptions()
+    p.Training = given
+    p.Atoms = 4
+    f(p)
+    return p
+  }
+  checkValidate(t, "local_coordinate_coding", []validateCase{
+    {"defaults", opts(func(p *options) {}), ""},
+    {"training without atoms", opts(func(p *options) { p.Atoms = 0 }),
+        "atoms"},
+    {"initial dictionary without training", opts(func(p *options) {
+      p.Training, p.InputModel = nil, &LocalCoordinateCodingModel{}
+      p.InitialDictionary = given
+    }), "initial_dictionary"},
+  })
+}
+
+func TestValidateLogisticRegression(t *testing.T) {
+  type options = LogisticRegressionOptionalParam
+  opts := func(f func(p *options)) *options {
+    p := LogisticRegressionOptions()
+    p.Training = given
+    p.Labels = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "logistic_regression", []validateCase{
+    {"defaults", opts(func(p *options) {}), ""},
+    {"decision boundary above 1", opts(func(p *options) {
+      p.DecisionBoundary = 1.5
+    }), "decision_boundary"},
+    {"optimizer of linear svm only", opts(func(p *options) {
+      p.Optimizer = OptimizerPSGD
+    }), "optimizer"},
+  })
+}
+
+func TestValidateLsh(t *testing.T) {
+  opts := func(f func(p *LshOptionalParam)) *LshOptionalParam {
+    p := LshOptions()
+    p.Reference = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "lsh", []validateCase{
+    {"defaults", opts(func(p *LshOptionalParam) {}), ""},
+    {"both", opts(func(p *LshOptionalParam) {
+      p.InputModel = &LSHSearchModel{}
+    }), "input_model"},
+    {"zero tables", opts(func(p *LshOptionalParam) { p.Tables = 0 }),
+        "tables"},
+    {"true neighbors without k", opts(func(p *LshOptionalParam) {
+      p.TrueNeighbors = given
+    }), "true_neighbors"},
+  })
+}
+
+func TestValidateClassifiers(t *testing.T) {
+  nbc := NbcOptions()
+  nbc.Labels = given
+  nbc.InputModel = &NBCModel{}
+  checkValidate(t, "nbc", []validateCase{
+    {"labels without training", nbc, "labels"},
+  })
+
+  perceptron := PerceptronOptions()
+  perceptron.Training = given
+  perceptron.MaxIterations = -1
+  checkValidate(t, "perceptron", []validateCase{
+    {"negative max iterations", perceptron, "max_iterations"},
+  })
+
+  softmax := SoftmaxRegressionOptions()
+  softmax.Training = given
+  softmax.TestLabels = given
+  checkValidate(t, "softmax_regression", []validateCase{
+    {"test labels without test", softmax, "test_labels"},
+  })
+
+  type forest = RandomForestOptionalParam
+  rf := func(f func(p *forest)) *forest {
+    p := RandomForestOptions()
+    p.Training = given
+    p.Labels = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "random_forest", []validateCase{
+    {"defaults", rf(func(p *forest) {}), ""},
+    {"both without warm start", rf(func(p *forest) {
+      p.InputModel = &RandomForestModel{}
+    }), "input_model"},
+    {"both with warm start", rf(func(p *forest) {
+      p.InputModel = &RandomForestModel{}
+      p.WarmStart = true
+    }), ""},
+    {"warm start without input model", rf(func(p *forest) {
+      p.WarmStart = true
+    }), "warm_start"},
+    {"zero trees", rf(func(p *forest) { p.NumTrees = 0 }), "num_trees"},
+  })
+}
+
+func TestValidateNca(t *testing.T) {
+  opts := func(f func(p *NcaOptionalParam)) *NcaOptionalParam {
+    p := NcaOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "nca", []validateCase{
+    {"defaults", opts(func(p *NcaOptionalParam) {}), ""},
+    {"armijo constant of 1", opts(func(p *NcaOptionalParam) {
+      p.ArmijoConstant = 1
+    }), "armijo_constant"},
+    {"max step below min step", opts(func(p *NcaOptionalParam) {
+      p.MinStep = 1
+      p.MaxStep = 0.5
+    }), "max_step"},
+    {"invalid optimizer", opts(func(p *NcaOptionalParam) {
+      p.Optimizer = OptimizerPSGD
+    }), "optimizer"},
+    {"wolfe of 0", opts(func(p *NcaOptionalParam) { p.Wolfe = 0 }), "wolfe"},
+  })
+}
+
+func TestValidateDecompositions(t *testing.T) {
+  nmf := NmfOptions()
+  nmf.UpdateRules = "sgd"
+  checkValidate(t, "nmf", []validateCase{
+    {"defaults", NmfOptions(), ""},
+    {"invalid update rules", nmf, "update_rules"},
+  })
+
+  pca := PcaOptions()
+  pca.DecompositionMethod = "qr"
+  retain := PcaOptions()
+  retain.VarToRetain = 1.5
+  checkValidate(t, "pca", []validateCase{
+    {"defaults", PcaOptions(), ""},
+    {"invalid decomposition method", pca, "decomposition_method"},
+    {"var to retain above 1", retain, "var_to_retain"},
+  })
+
+  radical := RadicalOptions()
+  radical.Angles = 0
+  checkValidate(t, "radical", []validateCase{
+    {"defaults", RadicalOptions(), ""},
+    {"zero angles", radical, "angles"},
+  })
+
+  type coding = SparseCodingOptionalParam
+  sc := func(f func(p *coding)) *coding {
+    p := SparseCodingOptions()
+    p.Training = given
+    f(p)
+    return p
+  }
+  checkValidate(t, "sparse_coding", []validateCase{
+    {"defaults", sc(func(p *coding) {}), ""},
+    {"zero atoms", sc(func(p *coding) { p.Atoms = 0 }), "atoms"},
+    {"both", sc(func(p *coding) { p.InputModel = &SparseCodingModel{} }),
+        "input_model"},
+  })
+
+  mean := MeanShiftOptions()
+  mean.MaxIterations = -1
+  checkValidate(t, "mean_shift", []validateCase{
+    {"defaults", MeanShiftOptions(), ""},
+    {"negative max iterations", mean, "max_iterations"},
+  })
+}
+
+func TestValidatePreprocess(t *testing.T) {
+  binarize := PreprocessBinarizeOptions()
+  binarize.Dimension = -1
+  checkValidate(t, "preprocess_binarize", []validateCase{
+    {"defaults", PreprocessBinarizeOptions(), ""},
+    {"negative dimension", binarize, "dimension"},
+  })
+
+  describe := PreprocessDescribeOptions()
+  describe.Precision = -1
+  checkValidate(t, "preprocess_describe", []validateCase{
+    {"defaults", PreprocessDescribeOptions(), ""},
+    {"negative precision", describe, "precision"},
+  })
+
+  oneHot := PreprocessOneHotEncodingOptions()
+  oneHot.Dimensions = []int{0, -2}
+  checkValidate(t, "preprocess_one_hot_encoding", []validateCase{
+    {"defaults", PreprocessOneHotEncodingOptions(), ""},
+    {"negative dimension", oneHot, "dimensions"},
+  })
+
+  type scale = PreprocessScaleOptionalParam
+  sc := func(f func(p *scale)) *scale {
+    p := PreprocessScaleOptions()
+    f(p)
+    return p
+  }
+  checkValidate(t, "preprocess_scale", []validateCase{
+    {"defaults", sc(func(p *scale) {}), ""},
+    {"invalid scaler method", sc(func(p *scale) {
+      p.ScalerMethod = "robust"
+    }), "scaler_method"},
+    {"min max with empty range", sc(func(p *scale) {
+      p.ScalerMethod = ScalerMinMax
+      p.MinValue, p.MaxValue = 1, 1
+    }), "max_value"},
+    {"epsilon above 1", sc(func(p *scale) { p.Epsilon = 2 }), "epsilon"},
+  })
+
+  split := PreprocessSplitOptions()
+  split.StratifyData = true
+  ratio := PreprocessSplitOptions()
+  ratio.TestRatio = -0.1
+  checkValidate(t, "preprocess_split", []validateCase{
+    {"defaults", PreprocessSplitOptions(), ""},
+    {"stratify without labels", split, "stratify_data"},
+    {"negative test ratio", ratio, "test_ratio"},
+  })
+}
@@ -0,0 +1,32 @@
+package mlpack
+
+import "fmt"
+
+// BindingsVersion is the mlpack version that this package was generated from,
+// followed by the revision of the C API in capi/ that the libraries must be
+// built from.  It must match MLPACK_VERSION and MLPACK_GO_CAPI in the Makefile.
+const BindingsVersion = "4.7.0+go-capi.1"
+
+// Version returns the version of the mlpack library that the package is
+// linked against, e.g. "4.7.0+go-capi.1", or "" in builds with the nomlpack
+// tag.
+func Version() string {
+  return libraryVersion()
+}
+
+// CheckVersion returns an error if the linked mlpack libraries were built from
+// a different mlpack version or C API revision than this package.  The
+// libmlpack_go_* libraries are built together, so the check is made once for
+// all of them.  Calling it at startup turns a mismatch into a clear error
+// instead of undefined behavior later on.
+func CheckVersion() error {
+  v := Version()
+  if v == "" {
+    return ErrNotAvailable
+  }
+  if v != BindingsVersion {
+    return fmt.Errorf("mlpack: linked libraries are version %s, but the Go " +
+        "package was generated for version %s", v, BindingsVersion)
+  }
+  return nil
+}
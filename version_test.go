@@ -0,0 +1,28 @@
+package mlpack
+
+import (
+  "io/ioutil"
+  "regexp"
+  "testing"
+)
+
+// BindingsVersion must name the versions that the Makefile builds.
+func TestBindingsVersionMatchesMakefile(t *testing.T) {
+  makefile, err := ioutil.ReadFile("Makefile")
+  if err != nil {
+    t.Fatal(err)
+  }
+  variable := func(name string) string {
+    m := regexp.MustCompile(`(?m)^` + name + `\?=(\S+)$`).FindSubmatch(
+        makefile)
+    if m == nil {
+      t.Fatalf("the Makefile does not set %s", name)
+    }
+    return string(m[1])
+  }
+  want := variable("MLPACK_VERSION") + "+" + variable("MLPACK_GO_CAPI")
+  if BindingsVersion != want {
+    t.Errorf("BindingsVersion is %s, but the Makefile builds %s",
+        BindingsVersion, want)
+  }
+}